@@ -0,0 +1,114 @@
+// Package checksum 实现常见的带校验位的标识符校验：Luhn（银行卡号）、IBAN、ISBN 和 EAN，
+// 供 vgen 生成的 Validate() 方法使用。所有实现都是纯 Go，没有其它依赖。
+package checksum
+
+import "strings"
+
+// IsLuhn 判断 s 是否为通过 Luhn（mod 10）校验的数字串，例如银行卡号 "4111111111111111"。
+// s 必须只包含数字且至少两位，不允许空格或连字符。
+func IsLuhn(s string) bool {
+	if len(s) < 2 || !isDigits(s) {
+		return false
+	}
+	sum := 0
+	for i := 0; i < len(s); i++ {
+		d := int(s[len(s)-1-i] - '0')
+		// 从右往左数，偶数位（校验位之后的每隔一位）乘以 2
+		if i%2 == 1 {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+	}
+	return sum%10 == 0
+}
+
+// IsIBAN 判断 s 是否为合法的国际银行账号，例如 "GB82WEST12345698765432"：
+// 国家代码必须在 IBAN 注册表中且长度与该国家的格式一致，并通过 ISO 7064 mod 97-10 校验。
+// s 必须是不含空格的大写电子格式。
+func IsIBAN(s string) bool {
+	if len(s) < 4 || ibanLengths[s[:2]] != len(s) || !isDigits(s[2:4]) {
+		return false
+	}
+	// 把前 4 个字符移到末尾，字母按 A=10 ... Z=35 展开后对 97 取余应为 1
+	rem := 0
+	for _, c := range s[4:] + s[:4] {
+		switch {
+		case '0' <= c && c <= '9':
+			rem = (rem*10 + int(c-'0')) % 97
+		case 'A' <= c && c <= 'Z':
+			rem = (rem*100 + int(c-'A'+10)) % 97
+		default:
+			return false
+		}
+	}
+	return rem == 1
+}
+
+// IsISBN 判断 s 是否为合法的 ISBN-10 或 ISBN-13，例如 "0306406152"、"978-3-16-148410-0"
+func IsISBN(s string) bool {
+	return IsISBN10(s) || IsISBN13(s)
+}
+
+// IsISBN10 判断 s 是否为合法的 ISBN-10：9 位数字加一位校验位（可以是 X），按权重 10..1 求和后能被 11 整除。
+// 数字之间可以用连字符或空格分隔。
+func IsISBN10(s string) bool {
+	s = stripSeparators(s)
+	if len(s) != 10 || !isDigits(s[:9]) {
+		return false
+	}
+	sum := 0
+	for i := 0; i < 9; i++ {
+		sum += (10 - i) * int(s[i]-'0')
+	}
+	switch c := s[9]; {
+	case c == 'X' || c == 'x':
+		sum += 10
+	case '0' <= c && c <= '9':
+		sum += int(c - '0')
+	default:
+		return false
+	}
+	return sum%11 == 0
+}
+
+// IsISBN13 判断 s 是否为合法的 ISBN-13：以 978 或 979 开头并通过 EAN-13 校验。
+// 数字之间可以用连字符或空格分隔。
+func IsISBN13(s string) bool {
+	s = stripSeparators(s)
+	return len(s) == 13 && (strings.HasPrefix(s, "978") || strings.HasPrefix(s, "979")) && IsEAN(s)
+}
+
+// IsEAN 判断 s 是否为合法的 EAN-8 或 EAN-13 商品条码，例如 "96385074"、"4006381333931"。
+// s 必须只包含数字。
+func IsEAN(s string) bool {
+	if len(s) != 8 && len(s) != 13 || !isDigits(s) {
+		return false
+	}
+	// 从校验位左边一位开始，从右往左交替乘以 3 和 1
+	sum := 0
+	for i := len(s) - 2; i >= 0; i-- {
+		d := int(s[i] - '0')
+		if (len(s)-2-i)%2 == 0 {
+			d *= 3
+		}
+		sum += d
+	}
+	return (10-sum%10)%10 == int(s[len(s)-1]-'0')
+}
+
+// stripSeparators 去掉 ISBN 中常见的连字符和空格
+func stripSeparators(s string) string {
+	return strings.NewReplacer("-", "", " ", "").Replace(s)
+}
+
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,75 @@
+package checksum
+
+import "testing"
+
+func TestChecksums(t *testing.T) {
+	tests := []struct {
+		name string
+		fn   func(string) bool
+		good []string
+		bad  []string
+	}{
+		{
+			"IsLuhn", IsLuhn,
+			[]string{"79927398713", "4111111111111111", "5555555555554444", "378282246310005", "6011111111111117", "00"},
+			[]string{"", "0", "79927398710", "4111111111111112", "4111 1111 1111 1111", "4111-1111-1111-1111", "411111111111111a"},
+		},
+		{
+			"IsIBAN", IsIBAN,
+			[]string{
+				"GB82WEST12345698765432", "DE89370400440532013000", "NL91ABNA0417164300", "FR1420041010050500013M02606",
+				"BE68539007547034", "NO9386011117947", "CH9300762011623852957", "MT84MALT011000012345MTLCAST001S",
+			},
+			[]string{
+				"", "GB", "GB82WEST12345698765433", "GB28WEST12345698765432", "DE8937040044053201300", "DE893704004405320130000",
+				"gb82west12345698765432", "GB82 WEST 1234 5698 7654 32", "ZZ82WEST12345698765432", "GBX2WEST12345698765432",
+			},
+		},
+		{
+			"IsISBN10", IsISBN10,
+			[]string{"0306406152", "080442957X", "0-306-40615-2", "0 306 40615 2", "080442957x"},
+			[]string{"", "0306406153", "030640615", "X306406152", "03064061520", "9780306406157"},
+		},
+		{
+			"IsISBN13", IsISBN13,
+			[]string{"9780306406157", "978-3-16-148410-0", "9791090636071"},
+			[]string{"", "9780306406158", "4006381333931", "978030640615", "0306406152"},
+		},
+		{
+			"IsISBN", IsISBN,
+			[]string{"0306406152", "9780306406157"},
+			[]string{"0306406153", "9780306406158", "96385074"},
+		},
+		{
+			"IsEAN", IsEAN,
+			[]string{"4006381333931", "5901234123457", "9780306406157", "96385074", "73513537"},
+			[]string{"", "4006381333932", "96385075", "400638133393", "4006-381333931", "123456789012a"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, s := range tt.good {
+				if !tt.fn(s) {
+					t.Errorf("%s(%q) = false, want true", tt.name, s)
+				}
+			}
+			for _, s := range tt.bad {
+				if tt.fn(s) {
+					t.Errorf("%s(%q) = true, want false", tt.name, s)
+				}
+			}
+		})
+	}
+}
+
+func TestIBANLengths(t *testing.T) {
+	for cc, n := range ibanLengths {
+		if len(cc) != 2 || cc[0] < 'A' || cc[0] > 'Z' || cc[1] < 'A' || cc[1] > 'Z' {
+			t.Errorf("invalid country code %q in IBAN table", cc)
+		}
+		if n < 15 || n > 34 {
+			t.Errorf("IBAN length %d for %s is outside 15..34", n, cc)
+		}
+	}
+}
@@ -1,25 +1,46 @@
-// examples/main.go
+// cmd/vgen/main.go
 package main
 
 import (
 	"fmt"
 	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
 
 	"github.com/hiramkuang/vgen/internal/generator"
 )
 
 func main() {
-	if len(os.Args) < 2 {
-		fmt.Println("Usage: vgen <file_path>")
-		os.Exit(1)
-	}
+	var opts generator.Options
 
-	filePath := os.Args[1]
+	rootCmd := &cobra.Command{
+		Use:   "vgen [flags] <FILE|DIR|PATTERN>...",
+		Short: "Generate Validate() methods from vgen struct tags",
+		Long: `vgen 根据结构体字段上的 vgen 标签生成 Validate() 方法。
 
-	if err := generator.GenerateValidator(filePath); err != nil {
+参数可以是单个 Go 源文件、目录，或者 go 命令支持的包模式（例如 ./...）。`,
+		Args:          cobra.MinimumNArgs(1),
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			written, err := generator.Generate(opts, args...)
+			wd, _ := os.Getwd()
+			for _, path := range written {
+				if rel, err := filepath.Rel(wd, path); err == nil {
+					path = rel
+				}
+				fmt.Printf("Successfully generated %s\n", path)
+			}
+			return err
+		},
+	}
+	rootCmd.Flags().BoolVarP(&opts.Recursive, "recursive", "r", false, "recursively process subdirectories of directory arguments")
+	rootCmd.Flags().BoolVar(&opts.Runes, "runes", false, "count string lengths in min, max and len in runes instead of bytes")
+	rootCmd.Flags().BoolVarP(&opts.Verbose, "verbose", "v", false, "print debug information")
+
+	if err := rootCmd.Execute(); err != nil {
 		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 		os.Exit(1)
 	}
-
-	fmt.Printf("Successfully generated validator for %s\n", filePath)
 }
@@ -0,0 +1,141 @@
+// Package codes 内嵌 ISO 3166-1 国家代码、ISO 4217 货币代码和 ISO 639-1 语言代码表，
+// 供 vgen 生成的 Validate() 方法校验 iso3166、iso4217 和 bcp47 规则，运行时不需要网络或其它依赖。
+package codes
+
+import "strings"
+
+var (
+	countries   = makeSet(countryTable, 0) // ISO 3166-1 alpha-2
+	countries3  = makeSet(countryTable, 1) // ISO 3166-1 alpha-3
+	currencies  = makeSet(currencyTable, 0)
+	languages   = makeSet(languageTable, 0)
+	grandfather = makeSet(irregularTags, 0)
+)
+
+// makeSet 把代码表中每行第 col 列的代码放入集合；表中每行是用空格分隔的若干列
+func makeSet(table string, col int) map[string]bool {
+	set := make(map[string]bool)
+	for _, line := range strings.Split(strings.TrimSpace(table), "\n") {
+		set[strings.Fields(line)[col]] = true
+	}
+	return set
+}
+
+// IsCountryCode 判断 s 是否为已分配的 ISO 3166-1 alpha-2 国家或地区代码，例如 "CN"、"US"（区分大小写）
+func IsCountryCode(s string) bool {
+	return len(s) == 2 && countries[s]
+}
+
+// IsCountryCode3 判断 s 是否为已分配的 ISO 3166-1 alpha-3 国家或地区代码，例如 "CHN"、"USA"（区分大小写）
+func IsCountryCode3(s string) bool {
+	return len(s) == 3 && countries3[s]
+}
+
+// IsCurrencyCode 判断 s 是否为现行的 ISO 4217 字母货币代码，例如 "CNY"、"EUR"（区分大小写）
+func IsCurrencyCode(s string) bool {
+	return len(s) == 3 && currencies[s]
+}
+
+// IsLanguageTag 判断 s 是否为合法的 BCP 47（RFC 5646）语言标签，例如 "zh-Hans-CN"、"en-US"、"de-CH-1996"。
+// 除了检查语法外，两个字母的主语言子标签必须是 ISO 639-1 代码；标签不区分大小写。
+func IsLanguageTag(s string) bool {
+	s = strings.ToLower(s)
+	if grandfather[s] {
+		return true
+	}
+	parts := strings.Split(s, "-")
+	for _, p := range parts {
+		if p == "" || len(p) > 8 || !isAlnum(p) {
+			return false
+		}
+	}
+	if parts[0] == "x" {
+		return privateUse(parts[1:])
+	}
+
+	// language：2~3 个字母（后面可以跟最多 3 个 extlang），4~8 个字母的语言子标签没有已注册的值
+	lang := parts[0]
+	switch {
+	case len(lang) == 2 && isAlpha(lang):
+		if !languages[lang] {
+			return false
+		}
+	case len(lang) == 3 && isAlpha(lang):
+	default:
+		return false
+	}
+	i := 1
+	for n := 0; n < 3 && i < len(parts) && len(parts[i]) == 3 && isAlpha(parts[i]); n++ {
+		i++
+	}
+
+	// script：4 个字母
+	if i < len(parts) && len(parts[i]) == 4 && isAlpha(parts[i]) {
+		i++
+	}
+	// region：2 个字母或 3 个数字
+	if i < len(parts) && (len(parts[i]) == 2 && isAlpha(parts[i]) || len(parts[i]) == 3 && isDigits(parts[i])) {
+		i++
+	}
+	// variant：5~8 个字母数字，或数字开头的 4 个字母数字；不能重复
+	variants := make(map[string]bool)
+	for ; i < len(parts) && (len(parts[i]) >= 5 || len(parts[i]) == 4 && isDigits(parts[i][:1])); i++ {
+		if variants[parts[i]] {
+			return false
+		}
+		variants[parts[i]] = true
+	}
+	// extension：除 x 以外的单字符 singleton 后跟一个或多个 2~8 位的子标签；singleton 不能重复
+	singletons := make(map[string]bool)
+	for i < len(parts) && len(parts[i]) == 1 && parts[i] != "x" {
+		if singletons[parts[i]] {
+			return false
+		}
+		singletons[parts[i]] = true
+		i++
+		start := i
+		for i < len(parts) && len(parts[i]) >= 2 {
+			i++
+		}
+		if i == start {
+			return false
+		}
+	}
+	// privateuse：x 后跟一个或多个 1~8 位的子标签
+	if i < len(parts) && parts[i] == "x" {
+		return privateUse(parts[i+1:])
+	}
+	return i == len(parts)
+}
+
+// privateUse 判断 x- 之后的子标签是否合法：至少一个，已由调用方检查长度和字符
+func privateUse(parts []string) bool {
+	return len(parts) > 0
+}
+
+func isAlpha(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < 'a' || s[i] > 'z' {
+			return false
+		}
+	}
+	return true
+}
+
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func isAlnum(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if (s[i] < 'a' || s[i] > 'z') && (s[i] < '0' || s[i] > '9') {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,59 @@
+package codes
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestTables(t *testing.T) {
+	if n := len(countries); n != 249 {
+		t.Errorf("ISO 3166-1 table has %d alpha-2 codes, want 249", n)
+	}
+	if len(countries3) != len(countries) {
+		t.Errorf("ISO 3166-1 table has %d alpha-3 codes for %d alpha-2 codes", len(countries3), len(countries))
+	}
+	for _, line := range strings.Split(strings.TrimSpace(countryTable), "\n") {
+		if f := strings.Fields(line); len(f) != 2 || len(f[0]) != 2 || len(f[1]) != 3 || strings.ToUpper(line) != line {
+			t.Errorf("malformed country table line %q", line)
+		}
+	}
+}
+
+func TestCodes(t *testing.T) {
+	tests := []struct {
+		name string
+		fn   func(string) bool
+		good []string
+		bad  []string
+	}{
+		{"IsCountryCode", IsCountryCode, []string{"CN", "US", "DE", "SS", "TW", "AX"}, []string{"", "cn", "UK", "XK", "EU", "CHN", "C"}},
+		{"IsCountryCode3", IsCountryCode3, []string{"CHN", "USA", "DEU", "SSD", "GBR"}, []string{"", "chn", "UK", "GB", "EUR", "XXX"}},
+		{"IsCurrencyCode", IsCurrencyCode, []string{"CNY", "EUR", "USD", "JPY", "XAU", "SLE", "ZWG"}, []string{"", "usd", "RMB", "EURO", "US", "ABC"}},
+		{
+			"IsLanguageTag", IsLanguageTag,
+			[]string{
+				"en", "EN-us", "zh-Hans-CN", "zh-yue-HK", "sr-Latn-RS", "es-419", "de-CH-1996", "sl-rozaj-biske",
+				"en-US-u-ca-gregory", "en-a-bbb-x-a-ccc", "x-whatever", "i-klingon", "en-GB-oed", "haw", "qaa",
+			},
+			[]string{
+				"", "e", "english", "xx", "en-", "-en", "en--US", "en_US", "en-US-u", "de-419-DE", "en-a-bbb-a-ccc",
+				"de-1996-1996", "x", "en-x", "en-toolongsubtag", "abcd-US", "en-US-abcd",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, s := range tt.good {
+				if !tt.fn(s) {
+					t.Errorf("%s(%q) = false, want true", tt.name, s)
+				}
+			}
+			for _, s := range tt.bad {
+				if tt.fn(s) {
+					t.Errorf("%s(%q) = true, want false", tt.name, s)
+				}
+			}
+		})
+	}
+}
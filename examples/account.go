@@ -0,0 +1,11 @@
+// examples/account.go
+package main
+
+// Account 演示字符类规则
+type Account struct {
+	Handle   string `vgen:"required,alphanum,lowercase"`
+	Initials string `vgen:"alpha,uppercase,max=3"`
+	PIN      string `vgen:"numeric,len=6"`
+	Slug     SKU    `vgen:"ascii"`
+	Motto    string `vgen:"omitempty,printascii"`
+}
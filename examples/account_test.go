@@ -0,0 +1,57 @@
+package main
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/hiramkuang/vgen/verr"
+)
+
+func TestAccountCharClasses(t *testing.T) {
+	valid := &Account{Handle: "diana42", Initials: "DW", PIN: "012345", Slug: "a-b_c", Motto: "Hello, world!"}
+	if err := valid.Validate(); err != nil {
+		t.Fatalf("Unexpected validation error for valid account: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		acc  Account
+		want []string // "路径:规则"，按字典序
+	}{
+		{"Empty", Account{}, []string{"Handle:alphanum", "Handle:required", "Initials:alpha", "PIN:len", "PIN:numeric"}},
+		{"HandleUpper", Account{Handle: "Diana", Initials: "D", PIN: "123456"}, []string{"Handle:lowercase"}},
+		{"HandleSymbols", Account{Handle: "di_na", Initials: "D", PIN: "123456"}, []string{"Handle:alphanum"}},
+		{"InitialsDigits", Account{Handle: "d", Initials: "D2", PIN: "123456"}, []string{"Initials:alpha"}},
+		{"InitialsLower", Account{Handle: "d", Initials: "Dw", PIN: "123456"}, []string{"Initials:uppercase"}},
+		{"NonASCIILetters", Account{Handle: "d", Initials: "É", PIN: "１２３４５６"}, []string{"Initials:alpha", "PIN:len", "PIN:numeric"}},
+		{"SlugNonASCII", Account{Handle: "d", Initials: "D", PIN: "123456", Slug: "café"}, []string{"Slug:ascii"}},
+		{"MottoControl", Account{Handle: "d", Initials: "D", PIN: "123456", Motto: "tab\there"}, []string{"Motto:printascii"}},
+		{"UnicodeCase", Account{Handle: "straße", Initials: "Ä", PIN: "123456"}, []string{"Handle:alphanum", "Initials:alpha"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := rulesOf(t, tt.acc.Validate()); !slices.Equal(got, tt.want) {
+				t.Errorf("Validate() errors = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAccountMessages(t *testing.T) {
+	acc := &Account{Handle: "Di", Initials: "D", PIN: "12345a"}
+	var errs verr.ValidationErrors
+	if err := acc.Validate(); !errors.As(err, &errs) {
+		t.Fatalf("Expected verr.ValidationErrors, got %v", err)
+	}
+	want := map[string]string{
+		"lowercase": "must not contain uppercase letters",
+		"numeric":   "must contain only digits",
+	}
+	for _, e := range errs {
+		if msg, ok := want[e.Rule]; ok && e.Msg != msg {
+			t.Errorf("%s error Msg = %q, want %q", e.Rule, e.Msg, msg)
+		}
+	}
+}
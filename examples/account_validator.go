@@ -0,0 +1,82 @@
+// Code generated by VGen. DO NOT EDIT.
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/hiramkuang/vgen/verr"
+)
+
+// Validate checks the fields of Account and returns all validation errors.
+func (s *Account) Validate() error {
+	var errs verr.ValidationErrors
+
+	if s.Handle == "" {
+		errs = append(errs, &verr.FieldError{
+			Path: "Handle", Field: "Handle", Rule: "required", Value: s.Handle,
+			Msg: "is required",
+		})
+	}
+	if !vgenIsAlphanum(s.Handle) {
+		errs = append(errs, &verr.FieldError{
+			Path: "Handle", Field: "Handle", Rule: "alphanum", Value: s.Handle,
+			Msg: "must contain only ASCII letters and digits",
+		})
+	}
+	if !vgenIsLowercase(s.Handle) {
+		errs = append(errs, &verr.FieldError{
+			Path: "Handle", Field: "Handle", Rule: "lowercase", Value: s.Handle,
+			Msg: "must not contain uppercase letters",
+		})
+	}
+	if !vgenIsAlpha(s.Initials) {
+		errs = append(errs, &verr.FieldError{
+			Path: "Initials", Field: "Initials", Rule: "alpha", Value: s.Initials,
+			Msg: "must contain only ASCII letters",
+		})
+	}
+	if !vgenIsUppercase(s.Initials) {
+		errs = append(errs, &verr.FieldError{
+			Path: "Initials", Field: "Initials", Rule: "uppercase", Value: s.Initials,
+			Msg: "must not contain lowercase letters",
+		})
+	}
+	if len(s.Initials) > 3 {
+		errs = append(errs, &verr.FieldError{
+			Path: "Initials", Field: "Initials", Rule: "max", Param: "3", Value: s.Initials,
+			Msg: fmt.Sprintf("length must be at most %d, got %d", 3, len(s.Initials)),
+		})
+	}
+	if !vgenIsNumeric(s.PIN) {
+		errs = append(errs, &verr.FieldError{
+			Path: "PIN", Field: "PIN", Rule: "numeric", Value: s.PIN,
+			Msg: "must contain only digits",
+		})
+	}
+	if len(s.PIN) != 6 {
+		errs = append(errs, &verr.FieldError{
+			Path: "PIN", Field: "PIN", Rule: "len", Param: "6", Value: s.PIN,
+			Msg: fmt.Sprintf("length must be %d, got %d", 6, len(s.PIN)),
+		})
+	}
+	if !vgenIsASCII(string(s.Slug)) {
+		errs = append(errs, &verr.FieldError{
+			Path: "Slug", Field: "Slug", Rule: "ascii", Value: s.Slug,
+			Msg: "must contain only ASCII characters",
+		})
+	}
+	if s.Motto != "" {
+		if !vgenIsPrintASCII(s.Motto) {
+			errs = append(errs, &verr.FieldError{
+				Path: "Motto", Field: "Motto", Rule: "printascii", Value: s.Motto,
+				Msg: "must contain only printable ASCII characters",
+			})
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
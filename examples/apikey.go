@@ -0,0 +1,12 @@
+// examples/apikey.go
+package main
+
+// APIKey 演示子串规则；值中的逗号、引号和 '|' 可以通过引号或反斜杠转义
+type APIKey struct {
+	Token       string `vgen:"required,startswith=sk_live_|sk_test_"` // 多个前缀满足其一即可
+	Label       string `vgen:"excludesall=<>\\|,excludes='DROP TABLE'|--"`
+	Owner       string `vgen:"contains=@"`
+	Callback    string `vgen:"omitempty,endswith=/hook"`
+	Scopes      string `vgen:"containsany=rwx"`
+	Description string `vgen:"excludes='a,b'|'it\\'s'"`
+}
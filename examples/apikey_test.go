@@ -0,0 +1,21 @@
+package main
+
+import "testing"
+
+func TestAPIKeySubstrings(t *testing.T) {
+	valid := func() APIKey {
+		return APIKey{Token: "sk_test_123", Label: "ci key", Owner: "ops@example.com", Scopes: "r", Description: "a, b"}
+	}
+	checkCases(t, valid, []fieldCase[APIKey]{
+		{"WrongPrefix", func(k *APIKey) { k.Token = "pk_live_123" }, "Token:startswith"},
+		{"LabelPipe", func(k *APIKey) { k.Label = "a|b" }, "Label:excludesall"},
+		{"LabelAngle", func(k *APIKey) { k.Label = "<script>" }, "Label:excludesall"},
+		{"LabelSQL", func(k *APIKey) { k.Label = "x; DROP TABLE keys" }, "Label:excludes"},
+		{"LabelComment", func(k *APIKey) { k.Label = "x -- y" }, "Label:excludes"},
+		{"OwnerNoAt", func(k *APIKey) { k.Owner = "ops" }, "Owner:contains"},
+		{"CallbackSuffix", func(k *APIKey) { k.Callback = "https://example.com/hooks" }, "Callback:endswith"},
+		{"ScopesNone", func(k *APIKey) { k.Scopes = "admin" }, "Scopes:containsany"},
+		{"DescriptionComma", func(k *APIKey) { k.Description = "a,b" }, "Description:excludes"},
+		{"DescriptionQuote", func(k *APIKey) { k.Description = "it's" }, "Description:excludes"},
+	})
+}
@@ -0,0 +1,70 @@
+// Code generated by VGen. DO NOT EDIT.
+
+package main
+
+import (
+	"strings"
+
+	"github.com/hiramkuang/vgen/verr"
+)
+
+// Validate checks the fields of APIKey and returns all validation errors.
+func (s *APIKey) Validate() error {
+	var errs verr.ValidationErrors
+
+	if s.Token == "" {
+		errs = append(errs, &verr.FieldError{
+			Path: "Token", Field: "Token", Rule: "required", Value: s.Token,
+			Msg: "is required",
+		})
+	}
+	if !(strings.HasPrefix(s.Token, "sk_live_") || strings.HasPrefix(s.Token, "sk_test_")) {
+		errs = append(errs, &verr.FieldError{
+			Path: "Token", Field: "Token", Rule: "startswith", Param: "sk_live_|sk_test_", Value: s.Token,
+			Msg: "must start with one of \"sk_live_\", \"sk_test_\"",
+		})
+	}
+	if strings.ContainsAny(s.Label, "<>|") {
+		errs = append(errs, &verr.FieldError{
+			Path: "Label", Field: "Label", Rule: "excludesall", Param: "<>|", Value: s.Label,
+			Msg: "must not contain any of the characters \"<>|\"",
+		})
+	}
+	if strings.Contains(s.Label, "DROP TABLE") || strings.Contains(s.Label, "--") {
+		errs = append(errs, &verr.FieldError{
+			Path: "Label", Field: "Label", Rule: "excludes", Param: "DROP TABLE|--", Value: s.Label,
+			Msg: "must not contain any of \"DROP TABLE\", \"--\"",
+		})
+	}
+	if !strings.Contains(s.Owner, "@") {
+		errs = append(errs, &verr.FieldError{
+			Path: "Owner", Field: "Owner", Rule: "contains", Param: "@", Value: s.Owner,
+			Msg: "must contain \"@\"",
+		})
+	}
+	if s.Callback != "" {
+		if !strings.HasSuffix(s.Callback, "/hook") {
+			errs = append(errs, &verr.FieldError{
+				Path: "Callback", Field: "Callback", Rule: "endswith", Param: "/hook", Value: s.Callback,
+				Msg: "must end with \"/hook\"",
+			})
+		}
+	}
+	if !strings.ContainsAny(s.Scopes, "rwx") {
+		errs = append(errs, &verr.FieldError{
+			Path: "Scopes", Field: "Scopes", Rule: "containsany", Param: "rwx", Value: s.Scopes,
+			Msg: "must contain at least one of the characters \"rwx\"",
+		})
+	}
+	if strings.Contains(s.Description, "a,b") || strings.Contains(s.Description, "it's") {
+		errs = append(errs, &verr.FieldError{
+			Path: "Description", Field: "Description", Rule: "excludes", Param: "a,b|it's", Value: s.Description,
+			Msg: "must not contain any of \"a,b\", \"it's\"",
+		})
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
@@ -0,0 +1,12 @@
+// examples/blob.go
+package main
+
+// Blob 演示编码规则：以字符串保存的不透明数据
+type Blob struct {
+	Metadata  string   `vgen:"required,json"`
+	Payload   string   `vgen:"base64"`
+	Cursor    string   `vgen:"omitempty,base64url"`
+	Digest    string   `vgen:"hex,len=64"`
+	Text      string   `vgen:"utf8"`
+	Documents []string `vgen:"dive,json"`
+}
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func validBlob() Blob {
+	return Blob{
+		Metadata:  `{"kind":"report","size":3}`,
+		Payload:   "aGVsbG8=",
+		Cursor:    "eyJwYWdlIjoyfQ",
+		Digest:    strings.Repeat("ab", 32),
+		Text:      "你好",
+		Documents: []string{"[]", `"x"`, "null"},
+	}
+}
+
+func TestBlobEncodings(t *testing.T) {
+	checkCases(t, validBlob, []fieldCase[Blob]{
+		{"MetadataTrailingComma", func(b *Blob) { b.Metadata = `{"a":1,}` }, "Metadata:json"},
+		{"PayloadURLAlphabet", func(b *Blob) { b.Payload = "a-_b" }, "Payload:base64"},
+		{"PayloadMissingPadding", func(b *Blob) { b.Payload = "aGVsbG8" }, "Payload:base64"},
+		{"PayloadLineBreak", func(b *Blob) { b.Payload = "aGVs\nbG8=" }, "Payload:base64"},
+		{"PayloadCRLF", func(b *Blob) { b.Payload = "aGVs\r\nbG8=" }, "Payload:base64"},
+		{"PayloadEmpty", func(b *Blob) { b.Payload = "" }, "Payload:base64"},
+		{"CursorStdAlphabet", func(b *Blob) { b.Cursor = "a+/b" }, "Cursor:base64url"},
+		{"CursorLineBreak", func(b *Blob) { b.Cursor = "eyJw\nYWdlIjoyfQ" }, "Cursor:base64url"},
+		{"DigestNonHexDigit", func(b *Blob) { b.Digest = strings.Repeat("a", 63) + "g" }, "Digest:hex"},
+		{"TextInvalidUTF8", func(b *Blob) { b.Text = "abc\xff" }, "Text:utf8"},
+		{"DocumentNotJSON", func(b *Blob) { b.Documents = append(b.Documents, "{") }, "Documents[3]:json"},
+	})
+
+	// 带填充和不带填充的 URL-safe base64 都可以接受
+	b := validBlob()
+	b.Cursor = "eyJwYWdlIjoyfQ=="
+	if err := b.Validate(); err != nil {
+		t.Errorf("Unexpected validation error for padded base64url: %v", err)
+	}
+}
@@ -0,0 +1,72 @@
+// Code generated by VGen. DO NOT EDIT.
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/hiramkuang/vgen/verr"
+)
+
+// Validate checks the fields of Blob and returns all validation errors.
+func (s *Blob) Validate() error {
+	var errs verr.ValidationErrors
+
+	if s.Metadata == "" {
+		errs = append(errs, &verr.FieldError{
+			Path: "Metadata", Field: "Metadata", Rule: "required", Value: s.Metadata,
+			Msg: "is required",
+		})
+	}
+	if !vgenIsJSON(s.Metadata) {
+		errs = append(errs, &verr.FieldError{
+			Path: "Metadata", Field: "Metadata", Rule: "json", Value: s.Metadata,
+			Msg: "is not valid JSON",
+		})
+	}
+	if !vgenIsBase64(s.Payload) {
+		errs = append(errs, &verr.FieldError{
+			Path: "Payload", Field: "Payload", Rule: "base64", Value: s.Payload,
+			Msg: "is not valid base64",
+		})
+	}
+	if s.Cursor != "" {
+		if !vgenIsBase64URL(s.Cursor) {
+			errs = append(errs, &verr.FieldError{
+				Path: "Cursor", Field: "Cursor", Rule: "base64url", Value: s.Cursor,
+				Msg: "is not valid URL-safe base64",
+			})
+		}
+	}
+	if !vgenIsHex(s.Digest) {
+		errs = append(errs, &verr.FieldError{
+			Path: "Digest", Field: "Digest", Rule: "hex", Value: s.Digest,
+			Msg: "is not a valid hexadecimal string",
+		})
+	}
+	if len(s.Digest) != 64 {
+		errs = append(errs, &verr.FieldError{
+			Path: "Digest", Field: "Digest", Rule: "len", Param: "64", Value: s.Digest,
+			Msg: fmt.Sprintf("length must be %d, got %d", 64, len(s.Digest)),
+		})
+	}
+	if !vgenIsUTF8(s.Text) {
+		errs = append(errs, &verr.FieldError{
+			Path: "Text", Field: "Text", Rule: "utf8", Value: s.Text,
+			Msg: "is not valid UTF-8",
+		})
+	}
+	for i, v := range s.Documents {
+		if !vgenIsJSON(v) {
+			errs = append(errs, &verr.FieldError{
+				Path: verr.Index("Documents", i), Field: "Documents", Rule: "json", Value: v,
+				Msg: "is not valid JSON",
+			})
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
@@ -0,0 +1,19 @@
+// examples/catalog.go
+package main
+
+// CatalogItem 是目录中的一项
+type CatalogItem struct {
+	SKU  SKU `vgen:"required"`
+	Name string
+}
+
+// Catalog 演示 unique 规则
+type Catalog struct {
+	Tags     []string          `vgen:"unique"`
+	IDs      [4]int            `vgen:"unique"`
+	Items    []CatalogItem     `vgen:"unique=SKU"` // 按 SKU 判断重复，错误路径形如 Items[2].SKU
+	Owners   map[string]string `vgen:"unique"`     // map 的值不能重复，错误路径指向按键排序后第一个重复的键
+	Slots    map[[2]int]string `vgen:"unique"`     // 键不可排序时错误路径为字段本身
+	Mirrors  *[]string         `vgen:"unique"`
+	Sections [][]int           `vgen:"dive,unique"`
+}
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hiramkuang/vgen/verr"
+)
+
+func TestCatalogUnique(t *testing.T) {
+	mirrors := []string{"a", "b"}
+	valid := &Catalog{
+		Tags:     []string{"go", "codegen"},
+		IDs:      [4]int{1, 2, 3, 4},
+		Items:    []CatalogItem{{SKU: "A1", Name: "x"}, {SKU: "B2", Name: "x"}},
+		Owners:   map[string]string{"a": "alice", "b": "bob"},
+		Mirrors:  &mirrors,
+		Sections: [][]int{{1, 2}, {1, 2}},
+	}
+	if err := valid.Validate(); err != nil {
+		t.Fatalf("Unexpected validation error for valid catalog: %v", err)
+	}
+
+	dupMirrors := []string{"a", "b", "a"}
+	invalid := &Catalog{
+		Tags:     []string{"go", "x", "go", "x"},
+		IDs:      [4]int{1, 2, 2, 0},
+		Items:    []CatalogItem{{SKU: "A1"}, {SKU: "B2"}, {SKU: "A1"}},
+		Owners:   map[string]string{"c": "alice", "a": "alice", "b": "bob", "d": "bob"},
+		Slots:    map[[2]int]string{{0, 1}: "x", {1, 0}: "x"},
+		Mirrors:  &dupMirrors,
+		Sections: [][]int{{1, 2}, {3, 3}},
+	}
+	var errs verr.ValidationErrors
+	if err := invalid.Validate(); !errors.As(err, &errs) {
+		t.Fatalf("Expected verr.ValidationErrors, got %v", err)
+	}
+	if len(errs) != 7 {
+		t.Fatalf("Expected 7 field errors, got %d: %v", len(errs), errs)
+	}
+	want := []struct {
+		path  string
+		value any
+	}{
+		{"Tags[2]", "go"},
+		{"IDs[2]", 2},
+		{"Items[2].SKU", SKU("A1")},
+		{"Owners[c]", "alice"}, // 按键排序后 c 是第一个与较小的键重复的键
+		{"Slots", invalid.Slots},
+		{"Mirrors[2]", "a"},
+		{"Sections[1][1]", 3},
+	}
+	for i, w := range want {
+		if errs[i].Path != w.path {
+			t.Errorf("errs[%d].Path = %q, want %q", i, errs[i].Path, w.path)
+		}
+		if errs[i].Rule != "unique" || !reflect.DeepEqual(errs[i].Value, w.value) {
+			t.Errorf("errs[%d] = {%s, %v}, want {unique, %v}", i, errs[i].Rule, errs[i].Value, w.value)
+		}
+	}
+
+	// 报告的键不依赖 map 的遍历顺序
+	for range 20 {
+		if err := invalid.Validate(); !errors.As(err, &errs) || errs[3].Path != "Owners[c]" {
+			t.Fatalf("Validate() reported %v, want Owners[c] every time", err)
+		}
+	}
+}
+
+func TestCatalogUniqueLarge(t *testing.T) {
+	// 超过逐对比较的阈值后改用 map 查重，结果应当一致
+	tags := make([]string, 100)
+	for i := range tags {
+		tags[i] = fmt.Sprint("tag-", i)
+	}
+	c := &Catalog{Tags: tags, IDs: [4]int{1, 2, 3, 4}}
+	if err := c.Validate(); err != nil {
+		t.Fatalf("Unexpected validation error for distinct tags: %v", err)
+	}
+
+	tags[90] = "tag-40"
+	tags[95] = "tag-3"
+	var errs verr.ValidationErrors
+	if err := c.Validate(); !errors.As(err, &errs) || len(errs) != 1 || errs[0].Path != "Tags[90]" {
+		t.Errorf("Validate() = %v, want a single Tags[90] error", err)
+	}
+}
@@ -0,0 +1,84 @@
+// Code generated by VGen. DO NOT EDIT.
+
+package main
+
+import (
+	"github.com/hiramkuang/vgen/verr"
+)
+
+// Validate checks the fields of CatalogItem and returns all validation errors.
+func (s *CatalogItem) Validate() error {
+	var errs verr.ValidationErrors
+
+	if s.SKU == "" {
+		errs = append(errs, &verr.FieldError{
+			Path: "SKU", Field: "SKU", Rule: "required", Value: s.SKU,
+			Msg: "is required",
+		})
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// Validate checks the fields of Catalog and returns all validation errors.
+func (s *Catalog) Validate() error {
+	var errs verr.ValidationErrors
+
+	if dup := vgenFirstDuplicate(s.Tags); dup >= 0 {
+		errs = append(errs, &verr.FieldError{
+			Path: verr.Index("Tags", dup), Field: "Tags", Rule: "unique", Value: s.Tags[dup],
+			Msg: "duplicate value",
+		})
+	}
+	if dup := vgenFirstDuplicate(s.IDs[:]); dup >= 0 {
+		errs = append(errs, &verr.FieldError{
+			Path: verr.Index("IDs", dup), Field: "IDs", Rule: "unique", Value: s.IDs[dup],
+			Msg: "duplicate value",
+		})
+	}
+	if dup := vgenFirstDuplicateBy(s.Items, func(e CatalogItem) SKU { return e.SKU }); dup >= 0 {
+		errs = append(errs, &verr.FieldError{
+			Path: verr.Index("Items", dup) + ".SKU", Field: "Items", Rule: "unique", Param: "SKU", Value: s.Items[dup].SKU,
+			Msg: "duplicate value",
+		})
+	}
+	for i := range s.Items {
+		errs = errs.Nest(verr.Index("Items", i), "Items", s.Items[i].Validate())
+	}
+	if dup, ok := vgenDuplicateMapValue(s.Owners); ok {
+		errs = append(errs, &verr.FieldError{
+			Path: verr.Key("Owners", dup), Field: "Owners", Rule: "unique", Value: s.Owners[dup],
+			Msg: "duplicate value",
+		})
+	}
+	if vgenHasDuplicateMapValue(s.Slots) {
+		errs = append(errs, &verr.FieldError{
+			Path: "Slots", Field: "Slots", Rule: "unique", Value: s.Slots,
+			Msg: "contains duplicate values",
+		})
+	}
+	if s.Mirrors != nil {
+		if dup := vgenFirstDuplicate(*s.Mirrors); dup >= 0 {
+			errs = append(errs, &verr.FieldError{
+				Path: verr.Index("Mirrors", dup), Field: "Mirrors", Rule: "unique", Value: (*s.Mirrors)[dup],
+				Msg: "duplicate value",
+			})
+		}
+	}
+	for i, v := range s.Sections {
+		if dup := vgenFirstDuplicate(v); dup >= 0 {
+			errs = append(errs, &verr.FieldError{
+				Path: verr.Index(verr.Index("Sections", i), dup), Field: "Sections", Rule: "unique", Value: v[dup],
+				Msg: "duplicate value",
+			})
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
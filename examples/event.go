@@ -0,0 +1,22 @@
+// examples/event.go
+package main
+
+import "time"
+
+// Event 演示 time.Time 和 time.Duration 上的规则
+type Event struct {
+	StartsAt  time.Time     `vgen:"required,future"`
+	CreatedAt time.Time     `vgen:"past,after=2020-01-01"`
+	Deadline  *time.Time    `vgen:"before=2030-01-01T00:00:00Z"`
+	Heartbeat time.Time     `vgen:"omitempty,within=24h"`
+	Timeout   time.Duration `vgen:"min=1s,max=1h30m"`
+	Interval  time.Duration `vgen:"gt=0,lte=500ms"`
+}
+
+// Reservation 演示字符串形式的日期时间
+type Reservation struct {
+	Date     string   `vgen:"required,datetime=2006-01-02"`
+	Time     string   `vgen:"omitempty,datetime=15:04"`
+	Stamp    string   `vgen:"datetime=2006-01-02T15:04:05Z07:00"`
+	Holidays []string `vgen:"dive,datetime=Jan _2"`
+}
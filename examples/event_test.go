@@ -0,0 +1,100 @@
+package main
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hiramkuang/vgen/verr"
+)
+
+// fixClock 把生成代码使用的时钟固定在 now，测试结束后恢复
+func fixClock(t *testing.T, now time.Time) {
+	t.Helper()
+	old := vgenNow
+	vgenNow = func() time.Time { return now }
+	t.Cleanup(func() { vgenNow = old })
+}
+
+func TestEventTimeRules(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	fixClock(t, now)
+
+	deadline := time.Date(2029, 12, 31, 0, 0, 0, 0, time.UTC)
+	valid := &Event{
+		StartsAt:  now.Add(time.Minute),
+		CreatedAt: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		Deadline:  &deadline,
+		Heartbeat: now.Add(-23 * time.Hour),
+		Timeout:   time.Second,
+		Interval:  500 * time.Millisecond,
+	}
+	if err := valid.Validate(); err != nil {
+		t.Fatalf("Unexpected validation error for valid event: %v", err)
+	}
+
+	late := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	invalid := &Event{
+		StartsAt:  now, // 不晚于当前时间
+		CreatedAt: time.Date(2019, 12, 31, 0, 0, 0, 0, time.UTC),
+		Deadline:  &late,
+		Heartbeat: now.Add(25 * time.Hour),
+		Timeout:   2 * time.Hour,
+		Interval:  0,
+	}
+	var errs verr.ValidationErrors
+	if err := invalid.Validate(); !errors.As(err, &errs) {
+		t.Fatalf("Expected verr.ValidationErrors, got %v", err)
+	}
+	want := []struct{ path, rule, msg string }{
+		{"StartsAt", "future", "must be in the future"},
+		{"CreatedAt", "after", "must be after 2020-01-01"},
+		{"Deadline", "before", "must be before 2030-01-01T00:00:00Z"},
+		{"Heartbeat", "within", "must be within 24h of the current time"},
+		{"Timeout", "max", "must be at most 1h30m, got 2h0m0s"},
+		{"Interval", "gt", "must be greater than 0, got 0s"},
+	}
+	if len(errs) != len(want) {
+		t.Fatalf("Expected %d field errors, got %d: %v", len(want), len(errs), errs)
+	}
+	for i, w := range want {
+		if errs[i].Path != w.path || errs[i].Rule != w.rule || errs[i].Msg != w.msg {
+			t.Errorf("errs[%d] = {%q, %q, %q}, want {%q, %q, %q}", i, errs[i].Path, errs[i].Rule, errs[i].Msg, w.path, w.rule, w.msg)
+		}
+	}
+
+	// 时钟前进后，过去的时间不再满足 future，未来的时间不满足 past
+	fixClock(t, now.AddDate(10, 0, 0))
+	valid.CreatedAt = now.AddDate(11, 0, 0)
+	got := rulesOf(t, valid.Validate())
+	if len(got) != 3 || got[0] != "CreatedAt:past" || got[1] != "Heartbeat:within" || got[2] != "StartsAt:future" {
+		t.Errorf("Validate() errors after advancing the clock = %q", got)
+	}
+}
+
+func TestReservationDatetime(t *testing.T) {
+	valid := &Reservation{Date: "2025-02-28", Time: "09:30", Stamp: "2025-02-28T09:30:00+08:00", Holidays: []string{"Jan  1", "Dec 25"}}
+	if err := valid.Validate(); err != nil {
+		t.Fatalf("Unexpected validation error for valid reservation: %v", err)
+	}
+
+	invalid := &Reservation{Date: "2025-02-30", Time: "9:30pm", Stamp: "2025-02-28 09:30:00", Holidays: []string{"Dec 25", "25 Dec"}}
+	var errs verr.ValidationErrors
+	if err := invalid.Validate(); !errors.As(err, &errs) {
+		t.Fatalf("Expected verr.ValidationErrors, got %v", err)
+	}
+	want := []struct{ path, msg string }{
+		{"Date", "must be a time in the layout 2006-01-02"},
+		{"Time", "must be a time in the layout 15:04"},
+		{"Stamp", "must be a time in the layout 2006-01-02T15:04:05Z07:00"},
+		{"Holidays[1]", "must be a time in the layout Jan _2"},
+	}
+	if len(errs) != len(want) {
+		t.Fatalf("Expected %d field errors, got %d: %v", len(want), len(errs), errs)
+	}
+	for i, w := range want {
+		if errs[i].Path != w.path || errs[i].Rule != "datetime" || errs[i].Msg != w.msg {
+			t.Errorf("errs[%d] = {%q, %q, %q}, want {%q, datetime, %q}", i, errs[i].Path, errs[i].Rule, errs[i].Msg, w.path, w.msg)
+		}
+	}
+}
@@ -0,0 +1,130 @@
+// Code generated by VGen. DO NOT EDIT.
+
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hiramkuang/vgen/verr"
+)
+
+// Validate checks the fields of Event and returns all validation errors.
+func (s *Event) Validate() error {
+	var errs verr.ValidationErrors
+
+	if s.StartsAt.IsZero() {
+		errs = append(errs, &verr.FieldError{
+			Path: "StartsAt", Field: "StartsAt", Rule: "required", Value: s.StartsAt,
+			Msg: "is required",
+		})
+	}
+	if !s.StartsAt.After(vgenNow()) {
+		errs = append(errs, &verr.FieldError{
+			Path: "StartsAt", Field: "StartsAt", Rule: "future", Value: s.StartsAt,
+			Msg: "must be in the future",
+		})
+	}
+	if !s.CreatedAt.Before(vgenNow()) {
+		errs = append(errs, &verr.FieldError{
+			Path: "CreatedAt", Field: "CreatedAt", Rule: "past", Value: s.CreatedAt,
+			Msg: "must be in the past",
+		})
+	}
+	if !s.CreatedAt.After(time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)) {
+		errs = append(errs, &verr.FieldError{
+			Path: "CreatedAt", Field: "CreatedAt", Rule: "after", Param: "2020-01-01", Value: s.CreatedAt,
+			Msg: "must be after 2020-01-01",
+		})
+	}
+	if s.Deadline != nil {
+		if !s.Deadline.Before(time.Date(2030, time.January, 1, 0, 0, 0, 0, time.UTC)) {
+			errs = append(errs, &verr.FieldError{
+				Path: "Deadline", Field: "Deadline", Rule: "before", Param: "2030-01-01T00:00:00Z", Value: *s.Deadline,
+				Msg: "must be before 2030-01-01T00:00:00Z",
+			})
+		}
+	}
+	if !s.Heartbeat.IsZero() {
+		if !vgenIsWithin(s.Heartbeat, 24*time.Hour) {
+			errs = append(errs, &verr.FieldError{
+				Path: "Heartbeat", Field: "Heartbeat", Rule: "within", Param: "24h", Value: s.Heartbeat,
+				Msg: "must be within 24h of the current time",
+			})
+		}
+	}
+	if s.Timeout < time.Second {
+		errs = append(errs, &verr.FieldError{
+			Path: "Timeout", Field: "Timeout", Rule: "min", Param: "1s", Value: s.Timeout,
+			Msg: fmt.Sprintf("must be at least 1s, got %v", s.Timeout),
+		})
+	}
+	if s.Timeout > 90*time.Minute {
+		errs = append(errs, &verr.FieldError{
+			Path: "Timeout", Field: "Timeout", Rule: "max", Param: "1h30m", Value: s.Timeout,
+			Msg: fmt.Sprintf("must be at most 1h30m, got %v", s.Timeout),
+		})
+	}
+	if s.Interval <= 0 {
+		errs = append(errs, &verr.FieldError{
+			Path: "Interval", Field: "Interval", Rule: "gt", Param: "0", Value: s.Interval,
+			Msg: fmt.Sprintf("must be greater than 0, got %v", s.Interval),
+		})
+	}
+	if s.Interval > 500*time.Millisecond {
+		errs = append(errs, &verr.FieldError{
+			Path: "Interval", Field: "Interval", Rule: "lte", Param: "500ms", Value: s.Interval,
+			Msg: fmt.Sprintf("must be less than or equal to 500ms, got %v", s.Interval),
+		})
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// Validate checks the fields of Reservation and returns all validation errors.
+func (s *Reservation) Validate() error {
+	var errs verr.ValidationErrors
+
+	if s.Date == "" {
+		errs = append(errs, &verr.FieldError{
+			Path: "Date", Field: "Date", Rule: "required", Value: s.Date,
+			Msg: "is required",
+		})
+	}
+	if _, err := time.Parse("2006-01-02", s.Date); err != nil {
+		errs = append(errs, &verr.FieldError{
+			Path: "Date", Field: "Date", Rule: "datetime", Param: "2006-01-02", Value: s.Date,
+			Msg: "must be a time in the layout 2006-01-02",
+		})
+	}
+	if s.Time != "" {
+		if _, err := time.Parse("15:04", s.Time); err != nil {
+			errs = append(errs, &verr.FieldError{
+				Path: "Time", Field: "Time", Rule: "datetime", Param: "15:04", Value: s.Time,
+				Msg: "must be a time in the layout 15:04",
+			})
+		}
+	}
+	if _, err := time.Parse("2006-01-02T15:04:05Z07:00", s.Stamp); err != nil {
+		errs = append(errs, &verr.FieldError{
+			Path: "Stamp", Field: "Stamp", Rule: "datetime", Param: "2006-01-02T15:04:05Z07:00", Value: s.Stamp,
+			Msg: "must be a time in the layout 2006-01-02T15:04:05Z07:00",
+		})
+	}
+	for i, v := range s.Holidays {
+		if _, err := time.Parse("Jan _2", v); err != nil {
+			errs = append(errs, &verr.FieldError{
+				Path: verr.Index("Holidays", i), Field: "Holidays", Rule: "datetime", Param: "Jan _2", Value: v,
+				Msg: "must be a time in the layout Jan _2",
+			})
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
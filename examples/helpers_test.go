@@ -0,0 +1,61 @@
+package main
+
+import (
+	"errors"
+	"sort"
+	"testing"
+
+	"github.com/hiramkuang/vgen/verr"
+)
+
+// rulesOf 返回校验错误的 "路径:规则" 列表，按字典序排序
+func rulesOf(t *testing.T, err error) []string {
+	t.Helper()
+	var errs verr.ValidationErrors
+	if !errors.As(err, &errs) {
+		t.Fatalf("Expected verr.ValidationErrors, got %v", err)
+	}
+	var got []string
+	for _, e := range errs {
+		got = append(got, e.Path+":"+e.Rule)
+	}
+	sort.Strings(got)
+	return got
+}
+
+// wantSingle 断言 err 只包含一个错误，且其 "路径:规则" 为 want
+func wantSingle(t *testing.T, err error, want string) {
+	t.Helper()
+	if got := rulesOf(t, err); len(got) != 1 || got[0] != want {
+		t.Errorf("Validate() = %v, want a single %s error", err, want)
+	}
+}
+
+// fieldCase 是一条单字段用例：set 在合法值的基础上修改字段，want 是期望的唯一错误 "路径:规则"
+type fieldCase[T any] struct {
+	name string
+	set  func(*T)
+	want string
+}
+
+// validatable 约束 *T 拥有生成的 Validate() 方法
+type validatable[T any] interface {
+	*T
+	Validate() error
+}
+
+// checkCases 先确认 valid() 返回的值能通过校验，再对每条用例在新的合法值上应用 set 并检查唯一的错误
+func checkCases[T any, P validatable[T]](t *testing.T, valid func() T, cases []fieldCase[T]) {
+	t.Helper()
+	v := valid()
+	if err := P(&v).Validate(); err != nil {
+		t.Fatalf("Unexpected validation error for valid value: %v", err)
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			v := valid()
+			tt.set(&v)
+			wantSingle(t, P(&v).Validate(), tt.want)
+		})
+	}
+}
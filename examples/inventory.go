@@ -0,0 +1,11 @@
+// examples/inventory.go
+package main
+
+// Warehouse 演示 pattern 规则；相同的正则表达式在包内只编译一次
+type Warehouse struct {
+	Code    string   `vgen:"required,pattern=^[A-Z]{3}-\\d+$"`
+	Backup  string   `vgen:"omitempty,pattern=^[A-Z]{3}-\\d+$"`
+	Zone    string   `vgen:"pattern='^(north|south)-[0-9]{2}$'"`
+	Region  SKU      `vgen:"pattern=^[a-z]{2}\\,[a-z]{2}$"` // 标签语法中的逗号需要转义
+	Shelves []string `vgen:"dive,pattern=^S\\d{3}$"`
+}
@@ -0,0 +1,33 @@
+package main
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hiramkuang/vgen/verr"
+)
+
+func TestWarehousePattern(t *testing.T) {
+	valid := &Warehouse{Code: "ABC-12", Zone: "north-07", Region: "eu,de", Shelves: []string{"S001"}}
+	if err := valid.Validate(); err != nil {
+		t.Fatalf("Unexpected validation error for valid warehouse: %v", err)
+	}
+
+	invalid := &Warehouse{Code: "abc-12", Backup: "ABC-", Zone: "east-07", Region: "eu;de", Shelves: []string{"S001", "S1"}}
+	var errs verr.ValidationErrors
+	if err := invalid.Validate(); !errors.As(err, &errs) {
+		t.Fatalf("Expected verr.ValidationErrors, got %v", err)
+	}
+	want := []string{"Code", "Backup", "Zone", "Region", "Shelves[1]"}
+	if len(errs) != len(want) {
+		t.Fatalf("Expected %d field errors, got %d: %v", len(want), len(errs), errs)
+	}
+	for i, w := range want {
+		if errs[i].Path != w || errs[i].Rule != "pattern" {
+			t.Errorf("errs[%d] = %s:%s, want %s:pattern", i, errs[i].Path, errs[i].Rule, w)
+		}
+	}
+	if errs[0].Param != `^[A-Z]{3}-\d+$` {
+		t.Errorf("errs[0].Param = %q, want the unescaped expression", errs[0].Param)
+	}
+}
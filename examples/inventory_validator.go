@@ -0,0 +1,58 @@
+// Code generated by VGen. DO NOT EDIT.
+
+package main
+
+import (
+	"github.com/hiramkuang/vgen/verr"
+)
+
+// Validate checks the fields of Warehouse and returns all validation errors.
+func (s *Warehouse) Validate() error {
+	var errs verr.ValidationErrors
+
+	if s.Code == "" {
+		errs = append(errs, &verr.FieldError{
+			Path: "Code", Field: "Code", Rule: "required", Value: s.Code,
+			Msg: "is required",
+		})
+	}
+	if !vgenPattern0.MatchString(s.Code) {
+		errs = append(errs, &verr.FieldError{
+			Path: "Code", Field: "Code", Rule: "pattern", Param: "^[A-Z]{3}-\\d+$", Value: s.Code,
+			Msg: "must match the pattern ^[A-Z]{3}-\\d+$",
+		})
+	}
+	if s.Backup != "" {
+		if !vgenPattern0.MatchString(s.Backup) {
+			errs = append(errs, &verr.FieldError{
+				Path: "Backup", Field: "Backup", Rule: "pattern", Param: "^[A-Z]{3}-\\d+$", Value: s.Backup,
+				Msg: "must match the pattern ^[A-Z]{3}-\\d+$",
+			})
+		}
+	}
+	if !vgenPattern1.MatchString(s.Zone) {
+		errs = append(errs, &verr.FieldError{
+			Path: "Zone", Field: "Zone", Rule: "pattern", Param: "^(north|south)-[0-9]{2}$", Value: s.Zone,
+			Msg: "must match the pattern ^(north|south)-[0-9]{2}$",
+		})
+	}
+	if !vgenPattern2.MatchString(string(s.Region)) {
+		errs = append(errs, &verr.FieldError{
+			Path: "Region", Field: "Region", Rule: "pattern", Param: "^[a-z]{2},[a-z]{2}$", Value: s.Region,
+			Msg: "must match the pattern ^[a-z]{2},[a-z]{2}$",
+		})
+	}
+	for i, v := range s.Shelves {
+		if !vgenPattern3.MatchString(v) {
+			errs = append(errs, &verr.FieldError{
+				Path: verr.Index("Shelves", i), Field: "Shelves", Rule: "pattern", Param: "^S\\d{3}$", Value: v,
+				Msg: "must match the pattern ^S\\d{3}$",
+			})
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
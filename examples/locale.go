@@ -0,0 +1,13 @@
+// examples/locale.go
+package main
+
+// Market 演示标识符和代码表规则：面向多个国家和地区的站点配置
+type Market struct {
+	Country   string   `vgen:"required,iso3166"`
+	Region    string   `vgen:"omitempty,iso3166_alpha3"`
+	Currency  string   `vgen:"required,iso4217"`
+	Locale    string   `vgen:"required,bcp47"`
+	Fallbacks []string `vgen:"dive,bcp47"`
+	Support   string   `vgen:"omitempty,e164"`
+	Release   string   `vgen:"semver"`
+}
@@ -0,0 +1,40 @@
+package main
+
+import "testing"
+
+func validMarket() Market {
+	return Market{
+		Country:   "CN",
+		Region:    "CHN",
+		Currency:  "CNY",
+		Locale:    "zh-Hans-CN",
+		Fallbacks: []string{"zh", "en-US", "i-klingon"},
+		Support:   "+8610123456789",
+		Release:   "1.4.0-rc.1+build.7",
+	}
+}
+
+func TestMarketCodes(t *testing.T) {
+	checkCases(t, validMarket, []fieldCase[Market]{
+		{"CountryLowercase", func(m *Market) { m.Country = "cn" }, "Country:iso3166"},
+		{"CountryUnassigned", func(m *Market) { m.Country = "UK" }, "Country:iso3166"},
+		{"RegionAlpha2", func(m *Market) { m.Region = "CN" }, "Region:iso3166_alpha3"},
+		{"CurrencyUnknown", func(m *Market) { m.Currency = "RMB" }, "Currency:iso4217"},
+		{"LocaleUnderscore", func(m *Market) { m.Locale = "zh_CN" }, "Locale:bcp47"},
+		{"LocaleUnknownLanguage", func(m *Market) { m.Locale = "xx-CN" }, "Locale:bcp47"},
+		{"FallbackEmptySubtag", func(m *Market) { m.Fallbacks[1] = "en--US" }, "Fallbacks[1]:bcp47"},
+		{"SupportMissingPlus", func(m *Market) { m.Support = "8610123456789" }, "Support:e164"},
+		{"SupportTooLong", func(m *Market) { m.Support = "+1234567890123456" }, "Support:e164"},
+		{"ReleaseLeadingV", func(m *Market) { m.Release = "v1.4.0" }, "Release:semver"},
+		{"ReleaseLeadingZero", func(m *Market) { m.Release = "1.04.0" }, "Release:semver"},
+		{"ReleaseNumericPrereleaseZero", func(m *Market) { m.Release = "1.4.0-rc.01" }, "Release:semver"},
+		{"ReleaseEmpty", func(m *Market) { m.Release = "" }, "Release:semver"},
+	})
+
+	// 构建元数据中的数字标识符允许前导零，可选字段为空时跳过校验
+	m := validMarket()
+	m.Release, m.Region, m.Support = "2.0.0+001", "", ""
+	if err := m.Validate(); err != nil {
+		t.Errorf("Unexpected validation error: %v", err)
+	}
+}
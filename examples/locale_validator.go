@@ -0,0 +1,85 @@
+// Code generated by VGen. DO NOT EDIT.
+
+package main
+
+import (
+	"github.com/hiramkuang/vgen/codes"
+	"github.com/hiramkuang/vgen/verr"
+)
+
+// Validate checks the fields of Market and returns all validation errors.
+func (s *Market) Validate() error {
+	var errs verr.ValidationErrors
+
+	if s.Country == "" {
+		errs = append(errs, &verr.FieldError{
+			Path: "Country", Field: "Country", Rule: "required", Value: s.Country,
+			Msg: "is required",
+		})
+	}
+	if !codes.IsCountryCode(s.Country) {
+		errs = append(errs, &verr.FieldError{
+			Path: "Country", Field: "Country", Rule: "iso3166", Value: s.Country,
+			Msg: "is not a valid ISO 3166-1 alpha-2 country code",
+		})
+	}
+	if s.Region != "" {
+		if !codes.IsCountryCode3(s.Region) {
+			errs = append(errs, &verr.FieldError{
+				Path: "Region", Field: "Region", Rule: "iso3166_alpha3", Value: s.Region,
+				Msg: "is not a valid ISO 3166-1 alpha-3 country code",
+			})
+		}
+	}
+	if s.Currency == "" {
+		errs = append(errs, &verr.FieldError{
+			Path: "Currency", Field: "Currency", Rule: "required", Value: s.Currency,
+			Msg: "is required",
+		})
+	}
+	if !codes.IsCurrencyCode(s.Currency) {
+		errs = append(errs, &verr.FieldError{
+			Path: "Currency", Field: "Currency", Rule: "iso4217", Value: s.Currency,
+			Msg: "is not a valid ISO 4217 currency code",
+		})
+	}
+	if s.Locale == "" {
+		errs = append(errs, &verr.FieldError{
+			Path: "Locale", Field: "Locale", Rule: "required", Value: s.Locale,
+			Msg: "is required",
+		})
+	}
+	if !codes.IsLanguageTag(s.Locale) {
+		errs = append(errs, &verr.FieldError{
+			Path: "Locale", Field: "Locale", Rule: "bcp47", Value: s.Locale,
+			Msg: "is not a valid BCP 47 language tag",
+		})
+	}
+	for i, v := range s.Fallbacks {
+		if !codes.IsLanguageTag(v) {
+			errs = append(errs, &verr.FieldError{
+				Path: verr.Index("Fallbacks", i), Field: "Fallbacks", Rule: "bcp47", Value: v,
+				Msg: "is not a valid BCP 47 language tag",
+			})
+		}
+	}
+	if s.Support != "" {
+		if !vgenIsE164(s.Support) {
+			errs = append(errs, &verr.FieldError{
+				Path: "Support", Field: "Support", Rule: "e164", Value: s.Support,
+				Msg: "is not a valid E.164 phone number",
+			})
+		}
+	}
+	if !vgenIsSemver(s.Release) {
+		errs = append(errs, &verr.FieldError{
+			Path: "Release", Field: "Release", Rule: "semver", Value: s.Release,
+			Msg: "is not a valid semantic version",
+		})
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
@@ -14,7 +14,7 @@ func main() {
 		Email:  "alice@example.com",
 		Age:    30,
 		City:   "Tokyo",  // 5个字符, 符合 len=5
-		Status: "active", // 在 in=active,pending,disabled 列表中
+		Status: "active", // 在 in=active|pending|disabled 列表中
 	}
 	if err := validUser.Validate(); err != nil {
 		log.Printf("Unexpected validation error for validUser: %v", err)
@@ -87,7 +87,7 @@ func main() {
 		Email:  "charlie@example.com",
 		Age:    35,
 		City:   "Paris",    // 长度为 5，符合 len=5
-		Status: "archived", // 不在 in=active,pending,disabled 列表中
+		Status: "archived", // 不在 in=active|pending|disabled 列表中
 	}
 	if err := userInvalidIn.Validate(); err != nil {
 		fmt.Printf("Validation failed for userInvalidIn as expected: %v\n", err)
@@ -102,7 +102,7 @@ func main() {
 		Email:  "diana@example.com",
 		Age:    28,
 		City:   "Milan",   // 长度为 5，符合 len=5
-		Status: "pending", // 在 in=active,pending,disabled 列表中
+		Status: "pending", // 在 in=active|pending|disabled 列表中
 	}
 	if err := userAllValid.Validate(); err != nil {
 		log.Printf("Unexpected validation error for userAllValid: %v", err)
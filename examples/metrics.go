@@ -0,0 +1,14 @@
+// examples/metrics.go
+package main
+
+// Reading 演示数值比较规则：字面量按字段类型解析，支持负数、浮点数和十六进制
+type Reading struct {
+	Celsius   float32 `vgen:"gte=-273.15,lt=1e4"`
+	Ratio     float64 `vgen:"gt=0,lte=1"`
+	Offset    int8    `vgen:"gte=-128,ne=0"`
+	Counter   uint32  `vgen:"lt=0xFFFFFFFF"`
+	Flags     uint8   `vgen:"lte=0b111"`
+	Version   int     `vgen:"eq=2"`
+	Sensor    string  `vgen:"ne=unknown"`
+	Calibrate bool    `vgen:"eq=true"`
+}
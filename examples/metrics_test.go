@@ -0,0 +1,55 @@
+package main
+
+import (
+	"errors"
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/hiramkuang/vgen/verr"
+)
+
+func TestReadingComparisons(t *testing.T) {
+	valid := Reading{
+		Celsius:   -273.15,
+		Ratio:     1,
+		Offset:    -128,
+		Counter:   math.MaxUint32 - 1,
+		Flags:     7,
+		Version:   2,
+		Sensor:    "probe-1",
+		Calibrate: true,
+	}
+	if err := valid.Validate(); err != nil {
+		t.Fatalf("Unexpected validation error for boundary values: %v", err)
+	}
+
+	invalid := Reading{
+		Celsius: -274,
+		Ratio:   0,
+		Offset:  0,
+		Counter: math.MaxUint32,
+		Flags:   8,
+		Version: 3,
+		Sensor:  "unknown",
+	}
+	err := invalid.Validate()
+	want := []string{
+		"Calibrate:eq",
+		"Celsius:gte",
+		"Counter:lt",
+		"Flags:lte",
+		"Offset:ne",
+		"Ratio:gt",
+		"Sensor:ne",
+		"Version:eq",
+	}
+	if got := rulesOf(t, err); !slices.Equal(got, want) {
+		t.Fatalf("Validate() errors = %q, want %q", got, want)
+	}
+	var errs verr.ValidationErrors
+	errors.As(err, &errs)
+	if msg := errs[3].Msg; msg != "must be less than 4294967295, got 4294967295" {
+		t.Errorf("Counter error Msg = %q", msg)
+	}
+}
@@ -0,0 +1,86 @@
+// Code generated by VGen. DO NOT EDIT.
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/hiramkuang/vgen/verr"
+)
+
+// Validate checks the fields of Reading and returns all validation errors.
+func (s *Reading) Validate() error {
+	var errs verr.ValidationErrors
+
+	if s.Celsius < -273.15 {
+		errs = append(errs, &verr.FieldError{
+			Path: "Celsius", Field: "Celsius", Rule: "gte", Param: "-273.15", Value: s.Celsius,
+			Msg: fmt.Sprintf("must be greater than or equal to -273.15, got %v", s.Celsius),
+		})
+	}
+	if s.Celsius >= 10000 {
+		errs = append(errs, &verr.FieldError{
+			Path: "Celsius", Field: "Celsius", Rule: "lt", Param: "1e4", Value: s.Celsius,
+			Msg: fmt.Sprintf("must be less than 10000, got %v", s.Celsius),
+		})
+	}
+	if s.Ratio <= 0 {
+		errs = append(errs, &verr.FieldError{
+			Path: "Ratio", Field: "Ratio", Rule: "gt", Param: "0", Value: s.Ratio,
+			Msg: fmt.Sprintf("must be greater than 0, got %v", s.Ratio),
+		})
+	}
+	if s.Ratio > 1 {
+		errs = append(errs, &verr.FieldError{
+			Path: "Ratio", Field: "Ratio", Rule: "lte", Param: "1", Value: s.Ratio,
+			Msg: fmt.Sprintf("must be less than or equal to 1, got %v", s.Ratio),
+		})
+	}
+	if s.Offset < -128 {
+		errs = append(errs, &verr.FieldError{
+			Path: "Offset", Field: "Offset", Rule: "gte", Param: "-128", Value: s.Offset,
+			Msg: fmt.Sprintf("must be greater than or equal to -128, got %v", s.Offset),
+		})
+	}
+	if s.Offset == 0 {
+		errs = append(errs, &verr.FieldError{
+			Path: "Offset", Field: "Offset", Rule: "ne", Param: "0", Value: s.Offset,
+			Msg: fmt.Sprintf("must not be equal to 0, got %v", s.Offset),
+		})
+	}
+	if s.Counter >= 4294967295 {
+		errs = append(errs, &verr.FieldError{
+			Path: "Counter", Field: "Counter", Rule: "lt", Param: "0xFFFFFFFF", Value: s.Counter,
+			Msg: fmt.Sprintf("must be less than 4294967295, got %v", s.Counter),
+		})
+	}
+	if s.Flags > 7 {
+		errs = append(errs, &verr.FieldError{
+			Path: "Flags", Field: "Flags", Rule: "lte", Param: "0b111", Value: s.Flags,
+			Msg: fmt.Sprintf("must be less than or equal to 7, got %v", s.Flags),
+		})
+	}
+	if s.Version != 2 {
+		errs = append(errs, &verr.FieldError{
+			Path: "Version", Field: "Version", Rule: "eq", Param: "2", Value: s.Version,
+			Msg: fmt.Sprintf("must be equal to 2, got %v", s.Version),
+		})
+	}
+	if s.Sensor == "unknown" {
+		errs = append(errs, &verr.FieldError{
+			Path: "Sensor", Field: "Sensor", Rule: "ne", Param: "unknown", Value: s.Sensor,
+			Msg: fmt.Sprintf("must not be equal to \"unknown\", got %q", s.Sensor),
+		})
+	}
+	if !s.Calibrate {
+		errs = append(errs, &verr.FieldError{
+			Path: "Calibrate", Field: "Calibrate", Rule: "eq", Param: "true", Value: s.Calibrate,
+			Msg: fmt.Sprintf("must be equal to true, got %v", s.Calibrate),
+		})
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
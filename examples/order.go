@@ -0,0 +1,35 @@
+// examples/order.go
+package main
+
+import "github.com/hiramkuang/vgen/examples/subdir"
+
+// Address 是收货地址
+type Address struct {
+	Street string `vgen:"required"`
+	Zip    string `vgen:"len=5"`
+}
+
+// Item 是订单中的一行商品
+type Item struct {
+	SKU SKU `vgen:"required,len=8"`
+	Qty int `vgen:"min=1"`
+}
+
+// Audit 记录创建者，嵌入到其它结构体中使用
+type Audit struct {
+	CreatedBy string `vgen:"required"`
+}
+
+// Order 演示嵌套结构体的校验：字段、指针、切片、map、嵌入结构体以及其它包中的类型
+type Order struct {
+	Audit                      // 嵌入结构体的字段被提升，错误路径为 CreatedBy
+	ID       string            `vgen:"required"`
+	Shipping Address           // 错误路径为 Shipping.Zip
+	Billing  *Address          // nil 时跳过
+	Items    []Item            `vgen:"min=1"` // 错误路径为 Items[1].Qty
+	Gifts    map[string]*Item  // 错误路径为 Gifts[bob].SKU
+	Meta     subdir.Data       // 其它包中生成的 Validate()
+	Draft    Address           `vgen:"-"` // 完全跳过
+	Extra    map[string]string // 不包含可校验结构体的字段不受影响
+	Children []*Order          // 递归结构
+}
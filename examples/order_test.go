@@ -0,0 +1,69 @@
+package main
+
+import (
+	"errors"
+	"sort"
+	"testing"
+
+	"github.com/hiramkuang/vgen/examples/subdir"
+	"github.com/hiramkuang/vgen/verr"
+)
+
+func validOrder() *Order {
+	return &Order{
+		Audit:    Audit{CreatedBy: "alice"},
+		ID:       "order-1",
+		Shipping: Address{Street: "1 Main St", Zip: "12345"},
+		Items:    []Item{{SKU: "AB-12345", Qty: 1}},
+		Meta:     subdir.Data{Value: "x"},
+	}
+}
+
+func TestOrderValidation(t *testing.T) {
+	if err := validOrder().Validate(); err != nil {
+		t.Fatalf("Unexpected validation error for valid order: %v", err)
+	}
+
+	order := validOrder()
+	order.Audit.CreatedBy = ""
+	order.Shipping.Zip = "123"
+	order.Billing = &Address{Zip: "12345"}
+	order.Items = append(order.Items, Item{SKU: "AB-12345", Qty: 0})
+	order.Gifts = map[string]*Item{"bob": {SKU: "short", Qty: 1}, "nil": nil}
+	order.Meta.Value = ""
+	order.Draft = Address{} // vgen:"-" 跳过
+	order.Children = []*Order{nil, {ID: "child"}}
+
+	var errs verr.ValidationErrors
+	if err := order.Validate(); !errors.As(err, &errs) {
+		t.Fatalf("Expected verr.ValidationErrors, got %v", err)
+	}
+
+	var got []string
+	for _, e := range errs {
+		got = append(got, e.Path+":"+e.Rule)
+	}
+	sort.Strings(got)
+	want := []string{
+		"Billing.Street:required",
+		"Children[1].CreatedBy:required",
+		"Children[1].Items:min",
+		"Children[1].Meta.Value:required",
+		"Children[1].Shipping.Street:required",
+		"Children[1].Shipping.Zip:len",
+		"CreatedBy:required",
+		"Gifts[bob].SKU:len",
+		"Items[1].Qty:min",
+		"Meta.Value:required",
+		"Shipping.Zip:len",
+	}
+	if len(got) != len(want) {
+		t.Fatalf("Validate() errors = %q, want %q", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Validate() errors = %q, want %q", got, want)
+			break
+		}
+	}
+}
@@ -0,0 +1,120 @@
+// Code generated by VGen. DO NOT EDIT.
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/hiramkuang/vgen/verr"
+)
+
+// Validate checks the fields of Address and returns all validation errors.
+func (s *Address) Validate() error {
+	var errs verr.ValidationErrors
+
+	if s.Street == "" {
+		errs = append(errs, &verr.FieldError{
+			Path: "Street", Field: "Street", Rule: "required", Value: s.Street,
+			Msg: "is required",
+		})
+	}
+	if len(s.Zip) != 5 {
+		errs = append(errs, &verr.FieldError{
+			Path: "Zip", Field: "Zip", Rule: "len", Param: "5", Value: s.Zip,
+			Msg: fmt.Sprintf("length must be %d, got %d", 5, len(s.Zip)),
+		})
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// Validate checks the fields of Item and returns all validation errors.
+func (s *Item) Validate() error {
+	var errs verr.ValidationErrors
+
+	if s.SKU == "" {
+		errs = append(errs, &verr.FieldError{
+			Path: "SKU", Field: "SKU", Rule: "required", Value: s.SKU,
+			Msg: "is required",
+		})
+	}
+	if len(s.SKU) != 8 {
+		errs = append(errs, &verr.FieldError{
+			Path: "SKU", Field: "SKU", Rule: "len", Param: "8", Value: s.SKU,
+			Msg: fmt.Sprintf("length must be %d, got %d", 8, len(s.SKU)),
+		})
+	}
+	if s.Qty < 1 {
+		errs = append(errs, &verr.FieldError{
+			Path: "Qty", Field: "Qty", Rule: "min", Param: "1", Value: s.Qty,
+			Msg: fmt.Sprintf("must be at least 1, got %v", s.Qty),
+		})
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// Validate checks the fields of Audit and returns all validation errors.
+func (s *Audit) Validate() error {
+	var errs verr.ValidationErrors
+
+	if s.CreatedBy == "" {
+		errs = append(errs, &verr.FieldError{
+			Path: "CreatedBy", Field: "CreatedBy", Rule: "required", Value: s.CreatedBy,
+			Msg: "is required",
+		})
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// Validate checks the fields of Order and returns all validation errors.
+func (s *Order) Validate() error {
+	var errs verr.ValidationErrors
+
+	errs = errs.Nest("", "Audit", s.Audit.Validate())
+	if s.ID == "" {
+		errs = append(errs, &verr.FieldError{
+			Path: "ID", Field: "ID", Rule: "required", Value: s.ID,
+			Msg: "is required",
+		})
+	}
+	errs = errs.Nest("Shipping", "Shipping", s.Shipping.Validate())
+	if s.Billing != nil {
+		errs = errs.Nest("Billing", "Billing", s.Billing.Validate())
+	}
+	if len(s.Items) < 1 {
+		errs = append(errs, &verr.FieldError{
+			Path: "Items", Field: "Items", Rule: "min", Param: "1", Value: s.Items,
+			Msg: fmt.Sprintf("length must be at least %d, got %d", 1, len(s.Items)),
+		})
+	}
+	for i := range s.Items {
+		errs = errs.Nest(verr.Index("Items", i), "Items", s.Items[i].Validate())
+	}
+	for k, e := range s.Gifts {
+		if e != nil {
+			errs = errs.Nest(verr.Key("Gifts", k), "Gifts", e.Validate())
+		}
+	}
+	errs = errs.Nest("Meta", "Meta", s.Meta.Validate())
+	for i, e := range s.Children {
+		if e != nil {
+			errs = errs.Nest(verr.Index("Children", i), "Children", e.Validate())
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
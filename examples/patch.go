@@ -0,0 +1,19 @@
+// examples/patch.go
+package main
+
+import "time"
+
+// UserPatch 演示 PATCH 风格的请求：nil 表示不修改该字段，非 nil 时才校验其指向的值
+type UserPatch struct {
+	ID       *int64     `vgen:"required,min=1"` // 必须非 nil，且指向的值至少为 1
+	Name     *string    `vgen:"min=2,max=50"`
+	Email    *string    `vgen:"omitempty,email"`
+	Age      *uint8     `vgen:"max=150"`
+	Role     *string    `vgen:"in=admin|member"`
+	Team     *string    `vgen:"required_if=Role:admin"` // 条件字段本身也可以是指针
+	Birthday *time.Time `vgen:"required_with=Age"`
+	Until    *time.Time `vgen:"gtfield=Since"`
+	Since    time.Time
+	Tags     *[]string `vgen:"max=3,dive,min=1"`
+	Aliases  []*string `vgen:"dive,required,min=2"`
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hiramkuang/vgen/verr"
+)
+
+// ptr 返回指向 v 的指针，便于构造 PATCH 请求
+func ptr[T any](v T) *T { return &v }
+
+func TestUserPatchNilFields(t *testing.T) {
+	// 除 ID 外全部为 nil：只检查 ID
+	patch := &UserPatch{ID: ptr(int64(7))}
+	if err := patch.Validate(); err != nil {
+		t.Fatalf("Unexpected validation error for minimal patch: %v", err)
+	}
+
+	since := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	patch = &UserPatch{
+		ID:       ptr(int64(7)),
+		Name:     ptr("Diana"),
+		Email:    ptr("diana@example.com"),
+		Age:      ptr(uint8(30)),
+		Role:     ptr("admin"),
+		Team:     ptr("core"),
+		Birthday: ptr(time.Date(1995, 3, 4, 0, 0, 0, 0, time.UTC)),
+		Since:    since,
+		Until:    ptr(since.Add(time.Hour)),
+		Tags:     &[]string{"a", "b"},
+		Aliases:  []*string{ptr("dd")},
+	}
+	if err := patch.Validate(); err != nil {
+		t.Fatalf("Unexpected validation error for full patch: %v", err)
+	}
+}
+
+func TestUserPatchInvalid(t *testing.T) {
+	since := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	patch := &UserPatch{
+		Name:    ptr(""), // 非 nil 的空字符串仍然要满足 min=2
+		Email:   ptr("nope"),
+		Age:     ptr(uint8(200)),
+		Role:    ptr("admin"),
+		Since:   since,
+		Until:   ptr(since),
+		Tags:    &[]string{"a", ""},
+		Aliases: []*string{nil, ptr("d")},
+	}
+
+	var errs verr.ValidationErrors
+	if err := patch.Validate(); !errors.As(err, &errs) {
+		t.Fatalf("Expected verr.ValidationErrors, got %v", err)
+	}
+	want := []string{
+		"ID:required",
+		"Name:min",
+		"Email:email",
+		"Age:max",
+		"Team:required_if",
+		"Birthday:required_with",
+		"Until:gtfield",
+		"Tags[1]:min",
+		"Aliases[0]:required",
+		"Aliases[1]:min",
+	}
+	if len(errs) != len(want) {
+		t.Fatalf("Expected %d field errors, got %d: %v", len(want), len(errs), errs)
+	}
+	for i, w := range want {
+		if got := errs[i].Path + ":" + errs[i].Rule; got != w {
+			t.Errorf("errs[%d] = %s, want %s", i, got, w)
+		}
+	}
+	if errs[3].Value != uint8(200) {
+		t.Errorf("Age error Value = %#v, want the dereferenced value", errs[3].Value)
+	}
+}
@@ -0,0 +1,126 @@
+// Code generated by VGen. DO NOT EDIT.
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/hiramkuang/vgen/verr"
+)
+
+// Validate checks the fields of UserPatch and returns all validation errors.
+func (s *UserPatch) Validate() error {
+	var errs verr.ValidationErrors
+
+	if s.ID == nil {
+		errs = append(errs, &verr.FieldError{
+			Path: "ID", Field: "ID", Rule: "required", Value: s.ID,
+			Msg: "is required",
+		})
+	}
+	if s.ID != nil {
+		if *s.ID < 1 {
+			errs = append(errs, &verr.FieldError{
+				Path: "ID", Field: "ID", Rule: "min", Param: "1", Value: *s.ID,
+				Msg: fmt.Sprintf("must be at least 1, got %v", *s.ID),
+			})
+		}
+	}
+	if s.Name != nil {
+		if len(*s.Name) < 2 {
+			errs = append(errs, &verr.FieldError{
+				Path: "Name", Field: "Name", Rule: "min", Param: "2", Value: *s.Name,
+				Msg: fmt.Sprintf("length must be at least %d, got %d", 2, len(*s.Name)),
+			})
+		}
+		if len(*s.Name) > 50 {
+			errs = append(errs, &verr.FieldError{
+				Path: "Name", Field: "Name", Rule: "max", Param: "50", Value: *s.Name,
+				Msg: fmt.Sprintf("length must be at most %d, got %d", 50, len(*s.Name)),
+			})
+		}
+	}
+	if s.Email != nil {
+		if !vgenIsEmailValid(*s.Email) {
+			errs = append(errs, &verr.FieldError{
+				Path: "Email", Field: "Email", Rule: "email", Value: *s.Email,
+				Msg: "is not a valid email",
+			})
+		}
+	}
+	if s.Age != nil {
+		if *s.Age > 150 {
+			errs = append(errs, &verr.FieldError{
+				Path: "Age", Field: "Age", Rule: "max", Param: "150", Value: *s.Age,
+				Msg: fmt.Sprintf("must be at most 150, got %v", *s.Age),
+			})
+		}
+	}
+	if s.Role != nil {
+		switch *s.Role {
+		case "admin", "member":
+		default:
+			errs = append(errs, &verr.FieldError{
+				Path: "Role", Field: "Role", Rule: "in", Param: "admin|member", Value: *s.Role,
+				Msg: fmt.Sprintf("value '%v' is not in the allowed list [%s]", *s.Role, "admin, member"),
+			})
+		}
+	}
+	if s.Role != nil && *s.Role == "admin" && s.Team == nil {
+		errs = append(errs, &verr.FieldError{
+			Path: "Team", Field: "Team", Rule: "required_if", Param: "Role:admin", Value: s.Team,
+			Msg: "is required when Role is admin",
+		})
+	}
+	if s.Age != nil && s.Birthday == nil {
+		errs = append(errs, &verr.FieldError{
+			Path: "Birthday", Field: "Birthday", Rule: "required_with", Param: "Age", Value: s.Birthday,
+			Msg: "is required when Age is present",
+		})
+	}
+	if s.Until != nil {
+		if !s.Until.After(s.Since) {
+			errs = append(errs, &verr.FieldError{
+				Path: "Until", Field: "Until", Rule: "gtfield", Param: "Since", Value: *s.Until,
+				Msg: "must be greater than Since",
+			})
+		}
+	}
+	if s.Tags != nil {
+		if len(*s.Tags) > 3 {
+			errs = append(errs, &verr.FieldError{
+				Path: "Tags", Field: "Tags", Rule: "max", Param: "3", Value: *s.Tags,
+				Msg: fmt.Sprintf("length must be at most %d, got %d", 3, len(*s.Tags)),
+			})
+		}
+		for i, v := range *s.Tags {
+			if len(v) < 1 {
+				errs = append(errs, &verr.FieldError{
+					Path: verr.Index("Tags", i), Field: "Tags", Rule: "min", Param: "1", Value: v,
+					Msg: fmt.Sprintf("length must be at least %d, got %d", 1, len(v)),
+				})
+			}
+		}
+	}
+	for i, v := range s.Aliases {
+		if v == nil {
+			errs = append(errs, &verr.FieldError{
+				Path: verr.Index("Aliases", i), Field: "Aliases", Rule: "required", Value: v,
+				Msg: "is required",
+			})
+		}
+		if v != nil {
+			if len(*v) < 2 {
+				errs = append(errs, &verr.FieldError{
+					Path: verr.Index("Aliases", i), Field: "Aliases", Rule: "min", Param: "2", Value: *v,
+					Msg: fmt.Sprintf("length must be at least %d, got %d", 2, len(*v)),
+				})
+			}
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
@@ -0,0 +1,16 @@
+// examples/payment.go
+package main
+
+// Payment 演示校验位规则：银行卡号和银行账号
+type Payment struct {
+	CardNumber string `vgen:"required_without=IBAN,omitempty,luhn,min=12,max=19"`
+	IBAN       string `vgen:"omitempty,iban"`
+}
+
+// Book 演示校验位规则：图书和商品条码
+type Book struct {
+	ISBN    string   `vgen:"required,isbn"`
+	ISBN13  string   `vgen:"omitempty,isbn13"`
+	Barcode string   `vgen:"omitempty,ean"`
+	Related []string `vgen:"dive,isbn10"`
+}
@@ -0,0 +1,35 @@
+package main
+
+import "testing"
+
+func TestPaymentChecksums(t *testing.T) {
+	card := func() Payment { return Payment{CardNumber: "4111111111111111"} }
+	checkCases(t, card, []fieldCase[Payment]{
+		{"Neither", func(p *Payment) { p.CardNumber = "" }, "CardNumber:required_without"},
+		{"CardTypo", func(p *Payment) { p.CardNumber = "4111111111111121" }, "CardNumber:luhn"},
+		{"CardTooShort", func(p *Payment) { p.CardNumber = "79927398713" }, "CardNumber:min"},
+	})
+
+	iban := func() Payment { return Payment{IBAN: "DE89370400440532013000"} }
+	checkCases(t, iban, []fieldCase[Payment]{
+		{"IBANCheckDigits", func(p *Payment) { p.IBAN = "DE88370400440532013000" }, "IBAN:iban"},
+		{"IBANWrongLength", func(p *Payment) { p.IBAN = "DE8937040044053201300" }, "IBAN:iban"},
+	})
+}
+
+func TestBookChecksums(t *testing.T) {
+	valid := func() Book {
+		return Book{
+			ISBN:    "0-306-40615-2",
+			ISBN13:  "978-3-16-148410-0",
+			Barcode: "96385074",
+			Related: []string{"080442957X"},
+		}
+	}
+	checkCases(t, valid, []fieldCase[Book]{
+		{"ISBNBadCheckDigit", func(b *Book) { b.ISBN = "0306406153" }, "ISBN:isbn"},
+		{"ISBN13NotBookland", func(b *Book) { b.ISBN13 = "4006381333931" }, "ISBN13:isbn13"},
+		{"BarcodeBadCheckDigit", func(b *Book) { b.Barcode = "4006381333932" }, "Barcode:ean"},
+		{"RelatedISBN13", func(b *Book) { b.Related = append(b.Related, "9780306406157") }, "Related[1]:isbn10"},
+	})
+}
@@ -0,0 +1,102 @@
+// Code generated by VGen. DO NOT EDIT.
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/hiramkuang/vgen/checksum"
+	"github.com/hiramkuang/vgen/verr"
+)
+
+// Validate checks the fields of Payment and returns all validation errors.
+func (s *Payment) Validate() error {
+	var errs verr.ValidationErrors
+
+	if s.IBAN == "" && s.CardNumber == "" {
+		errs = append(errs, &verr.FieldError{
+			Path: "CardNumber", Field: "CardNumber", Rule: "required_without", Param: "IBAN", Value: s.CardNumber,
+			Msg: "is required when IBAN is absent",
+		})
+	}
+	if s.CardNumber != "" {
+		if !checksum.IsLuhn(s.CardNumber) {
+			errs = append(errs, &verr.FieldError{
+				Path: "CardNumber", Field: "CardNumber", Rule: "luhn", Value: s.CardNumber,
+				Msg: "failed the Luhn checksum",
+			})
+		}
+		if len(s.CardNumber) < 12 {
+			errs = append(errs, &verr.FieldError{
+				Path: "CardNumber", Field: "CardNumber", Rule: "min", Param: "12", Value: s.CardNumber,
+				Msg: fmt.Sprintf("length must be at least %d, got %d", 12, len(s.CardNumber)),
+			})
+		}
+		if len(s.CardNumber) > 19 {
+			errs = append(errs, &verr.FieldError{
+				Path: "CardNumber", Field: "CardNumber", Rule: "max", Param: "19", Value: s.CardNumber,
+				Msg: fmt.Sprintf("length must be at most %d, got %d", 19, len(s.CardNumber)),
+			})
+		}
+	}
+	if s.IBAN != "" {
+		if !checksum.IsIBAN(s.IBAN) {
+			errs = append(errs, &verr.FieldError{
+				Path: "IBAN", Field: "IBAN", Rule: "iban", Value: s.IBAN,
+				Msg: "is not a valid IBAN",
+			})
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// Validate checks the fields of Book and returns all validation errors.
+func (s *Book) Validate() error {
+	var errs verr.ValidationErrors
+
+	if s.ISBN == "" {
+		errs = append(errs, &verr.FieldError{
+			Path: "ISBN", Field: "ISBN", Rule: "required", Value: s.ISBN,
+			Msg: "is required",
+		})
+	}
+	if !checksum.IsISBN(s.ISBN) {
+		errs = append(errs, &verr.FieldError{
+			Path: "ISBN", Field: "ISBN", Rule: "isbn", Value: s.ISBN,
+			Msg: "is not a valid ISBN",
+		})
+	}
+	if s.ISBN13 != "" {
+		if !checksum.IsISBN13(s.ISBN13) {
+			errs = append(errs, &verr.FieldError{
+				Path: "ISBN13", Field: "ISBN13", Rule: "isbn13", Value: s.ISBN13,
+				Msg: "is not a valid ISBN-13",
+			})
+		}
+	}
+	if s.Barcode != "" {
+		if !checksum.IsEAN(s.Barcode) {
+			errs = append(errs, &verr.FieldError{
+				Path: "Barcode", Field: "Barcode", Rule: "ean", Value: s.Barcode,
+				Msg: "is not a valid EAN-8 or EAN-13 barcode",
+			})
+		}
+	}
+	for i, v := range s.Related {
+		if !checksum.IsISBN10(v) {
+			errs = append(errs, &verr.FieldError{
+				Path: verr.Index("Related", i), Field: "Related", Rule: "isbn10", Value: v,
+				Msg: "is not a valid ISBN-10",
+			})
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
@@ -0,0 +1,12 @@
+// examples/post.go
+package main
+
+// Post 演示 dive 规则：对切片、数组和 map 的每个元素（以及 map 的键）应用规则
+type Post struct {
+	Title   string            `vgen:"required"`
+	Tags    []string          `vgen:"max=10,dive,min=1,max=32"`                   // 最多 10 个标签，每个 1~32 字节
+	Ratings map[string]int    `vgen:"dive,keys,min=2,max=16,endkeys,min=1,max=5"` // 键长 2~16，值 1~5
+	Grid    [][]int           `vgen:"max=3,dive,len=3,dive,min=0,max=9"`          // 嵌套 dive
+	Slots   [2]string         `vgen:"dive,required"`
+	Labels  map[string]string `vgen:"dive,keys,len=2,endkeys"` // 只校验键
+}
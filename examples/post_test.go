@@ -0,0 +1,62 @@
+package main
+
+import (
+	"errors"
+	"sort"
+	"testing"
+
+	"github.com/hiramkuang/vgen/verr"
+)
+
+func TestPostDive(t *testing.T) {
+	valid := &Post{
+		Title:   "Hello",
+		Tags:    []string{"go", "codegen"},
+		Ratings: map[string]int{"alice": 5},
+		Grid:    [][]int{{1, 2, 3}},
+		Slots:   [2]string{"a", "b"},
+		Labels:  map[string]string{"en": "English"},
+	}
+	if err := valid.Validate(); err != nil {
+		t.Fatalf("Unexpected validation error for valid post: %v", err)
+	}
+
+	post := &Post{
+		Title:   "Hello",
+		Tags:    []string{"go", "", "this-tag-is-definitely-longer-than-32-bytes"},
+		Ratings: map[string]int{"a": 3, "bob": 9},
+		Grid:    [][]int{{1, 2, 3}, {1, 10}},
+		Slots:   [2]string{"a", ""},
+		Labels:  map[string]string{"eng": "English"},
+	}
+
+	var errs verr.ValidationErrors
+	if err := post.Validate(); !errors.As(err, &errs) {
+		t.Fatalf("Expected verr.ValidationErrors, got %v", err)
+	}
+
+	var got []string
+	for _, e := range errs {
+		got = append(got, e.Path+":"+e.Rule)
+	}
+	sort.Strings(got)
+	want := []string{
+		"Grid[1]:len",
+		"Grid[1][1]:max",
+		"Labels[eng]:len",
+		"Ratings[a]:min",
+		"Ratings[bob]:max",
+		"Slots[1]:required",
+		"Tags[1]:min",
+		"Tags[2]:max",
+	}
+	if len(got) != len(want) {
+		t.Fatalf("Validate() errors = %q, want %q", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Validate() errors = %q, want %q", got, want)
+			break
+		}
+	}
+}
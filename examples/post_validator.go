@@ -0,0 +1,116 @@
+// Code generated by VGen. DO NOT EDIT.
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/hiramkuang/vgen/verr"
+)
+
+// Validate checks the fields of Post and returns all validation errors.
+func (s *Post) Validate() error {
+	var errs verr.ValidationErrors
+
+	if s.Title == "" {
+		errs = append(errs, &verr.FieldError{
+			Path: "Title", Field: "Title", Rule: "required", Value: s.Title,
+			Msg: "is required",
+		})
+	}
+	if len(s.Tags) > 10 {
+		errs = append(errs, &verr.FieldError{
+			Path: "Tags", Field: "Tags", Rule: "max", Param: "10", Value: s.Tags,
+			Msg: fmt.Sprintf("length must be at most %d, got %d", 10, len(s.Tags)),
+		})
+	}
+	for i, v := range s.Tags {
+		if len(v) < 1 {
+			errs = append(errs, &verr.FieldError{
+				Path: verr.Index("Tags", i), Field: "Tags", Rule: "min", Param: "1", Value: v,
+				Msg: fmt.Sprintf("length must be at least %d, got %d", 1, len(v)),
+			})
+		}
+		if len(v) > 32 {
+			errs = append(errs, &verr.FieldError{
+				Path: verr.Index("Tags", i), Field: "Tags", Rule: "max", Param: "32", Value: v,
+				Msg: fmt.Sprintf("length must be at most %d, got %d", 32, len(v)),
+			})
+		}
+	}
+	for k, v := range s.Ratings {
+		if len(k) < 2 {
+			errs = append(errs, &verr.FieldError{
+				Path: verr.Key("Ratings", k), Field: "Ratings", Rule: "min", Param: "2", Value: k,
+				Msg: fmt.Sprintf("length must be at least %d, got %d", 2, len(k)),
+			})
+		}
+		if len(k) > 16 {
+			errs = append(errs, &verr.FieldError{
+				Path: verr.Key("Ratings", k), Field: "Ratings", Rule: "max", Param: "16", Value: k,
+				Msg: fmt.Sprintf("length must be at most %d, got %d", 16, len(k)),
+			})
+		}
+		if v < 1 {
+			errs = append(errs, &verr.FieldError{
+				Path: verr.Key("Ratings", k), Field: "Ratings", Rule: "min", Param: "1", Value: v,
+				Msg: fmt.Sprintf("must be at least 1, got %v", v),
+			})
+		}
+		if v > 5 {
+			errs = append(errs, &verr.FieldError{
+				Path: verr.Key("Ratings", k), Field: "Ratings", Rule: "max", Param: "5", Value: v,
+				Msg: fmt.Sprintf("must be at most 5, got %v", v),
+			})
+		}
+	}
+	if len(s.Grid) > 3 {
+		errs = append(errs, &verr.FieldError{
+			Path: "Grid", Field: "Grid", Rule: "max", Param: "3", Value: s.Grid,
+			Msg: fmt.Sprintf("length must be at most %d, got %d", 3, len(s.Grid)),
+		})
+	}
+	for i, v := range s.Grid {
+		if len(v) != 3 {
+			errs = append(errs, &verr.FieldError{
+				Path: verr.Index("Grid", i), Field: "Grid", Rule: "len", Param: "3", Value: v,
+				Msg: fmt.Sprintf("length must be %d, got %d", 3, len(v)),
+			})
+		}
+		for i1, v1 := range v {
+			if v1 < 0 {
+				errs = append(errs, &verr.FieldError{
+					Path: verr.Index(verr.Index("Grid", i), i1), Field: "Grid", Rule: "min", Param: "0", Value: v1,
+					Msg: fmt.Sprintf("must be at least 0, got %v", v1),
+				})
+			}
+			if v1 > 9 {
+				errs = append(errs, &verr.FieldError{
+					Path: verr.Index(verr.Index("Grid", i), i1), Field: "Grid", Rule: "max", Param: "9", Value: v1,
+					Msg: fmt.Sprintf("must be at most 9, got %v", v1),
+				})
+			}
+		}
+	}
+	for i, v := range s.Slots {
+		if v == "" {
+			errs = append(errs, &verr.FieldError{
+				Path: verr.Index("Slots", i), Field: "Slots", Rule: "required", Value: v,
+				Msg: "is required",
+			})
+		}
+	}
+	for k := range s.Labels {
+		if len(k) != 2 {
+			errs = append(errs, &verr.FieldError{
+				Path: verr.Key("Labels", k), Field: "Labels", Rule: "len", Param: "2", Value: k,
+				Msg: fmt.Sprintf("length must be %d, got %d", 2, len(k)),
+			})
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
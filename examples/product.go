@@ -0,0 +1,22 @@
+// examples/product.go
+package main
+
+import "time"
+
+// SKU 是商品编号，用于演示命名字符串类型上的规则
+type SKU string
+
+// Email 是邮箱地址的命名类型
+type Email = string
+
+// Product represents a catalog item with validation rules on named, sized and imported types.
+type Product struct {
+	SKU       SKU       `vgen:"required,len=8"`        // 命名类型按底层的 string 处理
+	Price     float64   `vgen:"min=0.01,max=99999.99"` // 浮点数
+	Stock     int64     `vgen:"min=0,max=100000"`      // 任意位宽的整数
+	Weight    uint16    `vgen:"required,max=5000"`     // 无符号整数
+	Support   Email     `vgen:"email"`                 // 别名
+	Tags      []string  `vgen:"max=5"`                 // 切片长度
+	Released  time.Time `vgen:"required"`              // 其它包中的类型
+	Available bool      `vgen:"required"`              // bool 的零值是 false
+}
@@ -0,0 +1,35 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func validProduct() Product {
+	return Product{
+		SKU:       "AB-12345",
+		Price:     19.99,
+		Stock:     10,
+		Weight:    250,
+		Support:   "support@example.com",
+		Tags:      []string{"new"},
+		Released:  time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		Available: true,
+	}
+}
+
+func TestProductValidation(t *testing.T) {
+	checkCases(t, validProduct, []fieldCase[Product]{
+		{"SKUWrongLength", func(p *Product) { p.SKU = "AB-1" }, "SKU:len"},
+		{"PriceTooLow", func(p *Product) { p.Price = 0.001 }, "Price:min"},
+		{"PriceTooHigh", func(p *Product) { p.Price = 100000 }, "Price:max"},
+		{"StockNegative", func(p *Product) { p.Stock = -1 }, "Stock:min"},
+		{"StockTooHigh", func(p *Product) { p.Stock = 1 << 40 }, "Stock:max"},
+		{"WeightMissing", func(p *Product) { p.Weight = 0 }, "Weight:required"},
+		{"WeightTooHigh", func(p *Product) { p.Weight = 6000 }, "Weight:max"},
+		{"SupportInvalid", func(p *Product) { p.Support = "nobody" }, "Support:email"},
+		{"TooManyTags", func(p *Product) { p.Tags = []string{"a", "b", "c", "d", "e", "f"} }, "Tags:max"},
+		{"ReleasedMissing", func(p *Product) { p.Released = time.Time{} }, "Released:required"},
+		{"NotAvailable", func(p *Product) { p.Available = false }, "Available:required"},
+	})
+}
@@ -0,0 +1,92 @@
+// Code generated by VGen. DO NOT EDIT.
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/hiramkuang/vgen/verr"
+)
+
+// Validate checks the fields of Product and returns all validation errors.
+func (s *Product) Validate() error {
+	var errs verr.ValidationErrors
+
+	if s.SKU == "" {
+		errs = append(errs, &verr.FieldError{
+			Path: "SKU", Field: "SKU", Rule: "required", Value: s.SKU,
+			Msg: "is required",
+		})
+	}
+	if len(s.SKU) != 8 {
+		errs = append(errs, &verr.FieldError{
+			Path: "SKU", Field: "SKU", Rule: "len", Param: "8", Value: s.SKU,
+			Msg: fmt.Sprintf("length must be %d, got %d", 8, len(s.SKU)),
+		})
+	}
+	if s.Price < 0.01 {
+		errs = append(errs, &verr.FieldError{
+			Path: "Price", Field: "Price", Rule: "min", Param: "0.01", Value: s.Price,
+			Msg: fmt.Sprintf("must be at least 0.01, got %v", s.Price),
+		})
+	}
+	if s.Price > 99999.99 {
+		errs = append(errs, &verr.FieldError{
+			Path: "Price", Field: "Price", Rule: "max", Param: "99999.99", Value: s.Price,
+			Msg: fmt.Sprintf("must be at most 99999.99, got %v", s.Price),
+		})
+	}
+	if s.Stock < 0 {
+		errs = append(errs, &verr.FieldError{
+			Path: "Stock", Field: "Stock", Rule: "min", Param: "0", Value: s.Stock,
+			Msg: fmt.Sprintf("must be at least 0, got %v", s.Stock),
+		})
+	}
+	if s.Stock > 100000 {
+		errs = append(errs, &verr.FieldError{
+			Path: "Stock", Field: "Stock", Rule: "max", Param: "100000", Value: s.Stock,
+			Msg: fmt.Sprintf("must be at most 100000, got %v", s.Stock),
+		})
+	}
+	if s.Weight == 0 {
+		errs = append(errs, &verr.FieldError{
+			Path: "Weight", Field: "Weight", Rule: "required", Value: s.Weight,
+			Msg: "is required",
+		})
+	}
+	if s.Weight > 5000 {
+		errs = append(errs, &verr.FieldError{
+			Path: "Weight", Field: "Weight", Rule: "max", Param: "5000", Value: s.Weight,
+			Msg: fmt.Sprintf("must be at most 5000, got %v", s.Weight),
+		})
+	}
+	if !vgenIsEmailValid(s.Support) {
+		errs = append(errs, &verr.FieldError{
+			Path: "Support", Field: "Support", Rule: "email", Value: s.Support,
+			Msg: "is not a valid email",
+		})
+	}
+	if len(s.Tags) > 5 {
+		errs = append(errs, &verr.FieldError{
+			Path: "Tags", Field: "Tags", Rule: "max", Param: "5", Value: s.Tags,
+			Msg: fmt.Sprintf("length must be at most %d, got %d", 5, len(s.Tags)),
+		})
+	}
+	if s.Released.IsZero() {
+		errs = append(errs, &verr.FieldError{
+			Path: "Released", Field: "Released", Rule: "required", Value: s.Released,
+			Msg: "is required",
+		})
+	}
+	if !s.Available {
+		errs = append(errs, &verr.FieldError{
+			Path: "Available", Field: "Available", Rule: "required", Value: s.Available,
+			Msg: "is required",
+		})
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
@@ -0,0 +1,10 @@
+// examples/profile.go
+package main
+
+// Profile 演示按 Unicode 字符计数的长度规则：min/max/len 按字节计数，runemin/runemax/runelen 按字符计数
+type Profile struct {
+	DisplayName string   `vgen:"required,runemin=2,runemax=10"` // “张三”是 2 个字符、6 个字节
+	Initials    string   `vgen:"runelen=2"`
+	Bio         string   `vgen:"omitempty,runemax=140,max=512"` // 同时限制字符数和存储的字节数
+	Nicknames   []string `vgen:"dive,runemax=4"`
+}
@@ -0,0 +1,34 @@
+package main
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hiramkuang/vgen/verr"
+)
+
+func TestProfileRuneLength(t *testing.T) {
+	valid := &Profile{DisplayName: "张三", Initials: "ZS", Bio: "你好，世界", Nicknames: []string{"小张", "Zhan"}}
+	if err := valid.Validate(); err != nil {
+		t.Fatalf("Unexpected validation error for valid profile: %v", err)
+	}
+
+	invalid := &Profile{DisplayName: "张", Initials: "张三丰", Nicknames: []string{"张三丰老师"}}
+	var errs verr.ValidationErrors
+	if err := invalid.Validate(); !errors.As(err, &errs) {
+		t.Fatalf("Expected verr.ValidationErrors, got %v", err)
+	}
+	want := []struct{ path, rule, msg string }{
+		{"DisplayName", "runemin", "length must be at least 2 characters, got 1"},
+		{"Initials", "runelen", "length must be 2 characters, got 3"},
+		{"Nicknames[0]", "runemax", "length must be at most 4 characters, got 5"},
+	}
+	if len(errs) != len(want) {
+		t.Fatalf("Expected %d field errors, got %d: %v", len(want), len(errs), errs)
+	}
+	for i, w := range want {
+		if errs[i].Path != w.path || errs[i].Rule != w.rule || errs[i].Msg != w.msg {
+			t.Errorf("errs[%d] = {%q, %q, %q}, want {%q, %q, %q}", i, errs[i].Path, errs[i].Rule, errs[i].Msg, w.path, w.rule, w.msg)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+// Code generated by VGen. DO NOT EDIT.
+
+package main
+
+import (
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/hiramkuang/vgen/verr"
+)
+
+// Validate checks the fields of Profile and returns all validation errors.
+func (s *Profile) Validate() error {
+	var errs verr.ValidationErrors
+
+	if s.DisplayName == "" {
+		errs = append(errs, &verr.FieldError{
+			Path: "DisplayName", Field: "DisplayName", Rule: "required", Value: s.DisplayName,
+			Msg: "is required",
+		})
+	}
+	if utf8.RuneCountInString(s.DisplayName) < 2 {
+		errs = append(errs, &verr.FieldError{
+			Path: "DisplayName", Field: "DisplayName", Rule: "runemin", Param: "2", Value: s.DisplayName,
+			Msg: fmt.Sprintf("length must be at least %d characters, got %d", 2, utf8.RuneCountInString(s.DisplayName)),
+		})
+	}
+	if utf8.RuneCountInString(s.DisplayName) > 10 {
+		errs = append(errs, &verr.FieldError{
+			Path: "DisplayName", Field: "DisplayName", Rule: "runemax", Param: "10", Value: s.DisplayName,
+			Msg: fmt.Sprintf("length must be at most %d characters, got %d", 10, utf8.RuneCountInString(s.DisplayName)),
+		})
+	}
+	if utf8.RuneCountInString(s.Initials) != 2 {
+		errs = append(errs, &verr.FieldError{
+			Path: "Initials", Field: "Initials", Rule: "runelen", Param: "2", Value: s.Initials,
+			Msg: fmt.Sprintf("length must be %d characters, got %d", 2, utf8.RuneCountInString(s.Initials)),
+		})
+	}
+	if s.Bio != "" {
+		if utf8.RuneCountInString(s.Bio) > 140 {
+			errs = append(errs, &verr.FieldError{
+				Path: "Bio", Field: "Bio", Rule: "runemax", Param: "140", Value: s.Bio,
+				Msg: fmt.Sprintf("length must be at most %d characters, got %d", 140, utf8.RuneCountInString(s.Bio)),
+			})
+		}
+		if len(s.Bio) > 512 {
+			errs = append(errs, &verr.FieldError{
+				Path: "Bio", Field: "Bio", Rule: "max", Param: "512", Value: s.Bio,
+				Msg: fmt.Sprintf("length must be at most %d, got %d", 512, len(s.Bio)),
+			})
+		}
+	}
+	for i, v := range s.Nicknames {
+		if utf8.RuneCountInString(v) > 4 {
+			errs = append(errs, &verr.FieldError{
+				Path: verr.Index("Nicknames", i), Field: "Nicknames", Rule: "runemax", Param: "4", Value: v,
+				Msg: fmt.Sprintf("length must be at most %d characters, got %d", 4, utf8.RuneCountInString(v)),
+			})
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
@@ -0,0 +1,16 @@
+// examples/server.go
+package main
+
+// Endpoint 演示内置的字符串格式规则
+type Endpoint struct {
+	ID       string   `vgen:"uuid"`
+	Homepage string   `vgen:"url"`
+	Contact  string   `vgen:"uri"`
+	Host     string   `vgen:"hostname"`
+	Addr     string   `vgen:"ip"`
+	Gateway  string   `vgen:"ipv4"`
+	Gateway6 string   `vgen:"omitempty,ipv6"`
+	Subnet   string   `vgen:"cidr"`
+	HWAddr   string   `vgen:"mac"`
+	Mirrors  []string `vgen:"dive,url"`
+}
@@ -0,0 +1,133 @@
+package main
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/hiramkuang/vgen/verr"
+)
+
+func validEndpoint() Endpoint {
+	return Endpoint{
+		ID:       "123e4567-e89b-12d3-a456-426614174000",
+		Homepage: "https://example.com/docs?q=1",
+		Contact:  "mailto:ops@example.com",
+		Host:     "api-1.example.com",
+		Addr:     "2001:db8::1",
+		Gateway:  "192.168.0.1",
+		Subnet:   "10.0.0.0/8",
+		HWAddr:   "00:1a:2b:3c:4d:5e",
+		Mirrors:  []string{"http://mirror.example.org"},
+	}
+}
+
+func TestEndpointFormats(t *testing.T) {
+	e := validEndpoint()
+	if err := e.Validate(); err != nil {
+		t.Fatalf("Unexpected validation error for valid endpoint: %v", err)
+	}
+
+	tests := []struct {
+		field string
+		rule  string
+		set   func(*Endpoint, string)
+		good  []string
+		bad   []string
+	}{
+		{
+			field: "ID",
+			rule:  "uuid",
+			set:   func(e *Endpoint, s string) { e.ID = s },
+			good:  []string{"00000000-0000-0000-0000-000000000000", "123E4567-E89B-12D3-A456-426614174000"},
+			bad:   []string{"", "123e4567e89b12d3a456426614174000", "123e4567-e89b-12d3-a456-42661417400g", "{123e4567-e89b-12d3-a456-426614174000}"},
+		},
+		{
+			field: "Homepage",
+			rule:  "url",
+			set:   func(e *Endpoint, s string) { e.Homepage = s },
+			good:  []string{"http://localhost:8080", "ftp://files.example.com/a.txt"},
+			bad:   []string{"", "example.com", "/relative/path", "mailto:ops@example.com", "http://[::1"},
+		},
+		{
+			field: "Contact",
+			rule:  "uri",
+			set:   func(e *Endpoint, s string) { e.Contact = s },
+			good:  []string{"urn:isbn:0451450523", "https://example.com"},
+			bad:   []string{"", "/relative/path", "no-scheme"},
+		},
+		{
+			field: "Host",
+			rule:  "hostname",
+			set:   func(e *Endpoint, s string) { e.Host = s },
+			good:  []string{"localhost", "example.com.", "xn--bcher-kva.example", "1.example"},
+			bad:   []string{"", "-bad.example", "bad-.example", "a..b", "under_score.example", strings.Repeat("a", 64) + ".example"},
+		},
+		{
+			field: "Addr",
+			rule:  "ip",
+			set:   func(e *Endpoint, s string) { e.Addr = s },
+			good:  []string{"127.0.0.1", "::1", "::ffff:10.0.0.1"},
+			bad:   []string{"", "256.0.0.1", "fe80::1%eth0", "example.com"},
+		},
+		{
+			field: "Gateway",
+			rule:  "ipv4",
+			set:   func(e *Endpoint, s string) { e.Gateway = s },
+			good:  []string{"0.0.0.0", "255.255.255.255"},
+			bad:   []string{"", "::1", "1.2.3", "01.2.3.4"},
+		},
+		{
+			field: "Gateway6",
+			rule:  "ipv6",
+			set:   func(e *Endpoint, s string) { e.Gateway6 = s },
+			good:  []string{"", "fe80::1", "2001:db8:0:0:0:0:2:1"},
+			bad:   []string{"10.0.0.1", "fe80::1%eth0", "2001:db8:::1"},
+		},
+		{
+			field: "Subnet",
+			rule:  "cidr",
+			set:   func(e *Endpoint, s string) { e.Subnet = s },
+			good:  []string{"192.168.1.0/24", "2001:db8::/32"},
+			bad:   []string{"", "192.168.1.0", "10.0.0.0/33"},
+		},
+		{
+			field: "HWAddr",
+			rule:  "mac",
+			set:   func(e *Endpoint, s string) { e.HWAddr = s },
+			good:  []string{"00-1A-2B-3C-4D-5E", "0000.5e00.5301", "02:00:5e:10:00:00:00:01"},
+			bad:   []string{"", "00:1a:2b:3c:4d", "00:1a:2b:3c:4d:zz"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.field, func(t *testing.T) {
+			for _, s := range tt.good {
+				e := validEndpoint()
+				tt.set(&e, s)
+				if err := e.Validate(); err != nil {
+					t.Errorf("%s = %q: unexpected error %v", tt.field, s, err)
+				}
+			}
+			for _, s := range tt.bad {
+				t.Run(s, func(t *testing.T) {
+					e := validEndpoint()
+					tt.set(&e, s)
+					wantSingle(t, e.Validate(), tt.field+":"+tt.rule)
+				})
+			}
+		})
+	}
+}
+
+func TestEndpointMirrors(t *testing.T) {
+	e := validEndpoint()
+	e.Mirrors = append(e.Mirrors, "not a url")
+	var errs verr.ValidationErrors
+	if err := e.Validate(); !errors.As(err, &errs) {
+		t.Fatalf("Expected verr.ValidationErrors, got %v", err)
+	}
+	if len(errs) != 1 || errs[0].Path != "Mirrors[1]" || errs[0].Msg != "is not a valid URL" {
+		t.Errorf("Validate() = %v, want Mirrors[1] is not a valid URL", errs)
+	}
+}
@@ -0,0 +1,82 @@
+// Code generated by VGen. DO NOT EDIT.
+
+package main
+
+import (
+	"github.com/hiramkuang/vgen/verr"
+)
+
+// Validate checks the fields of Endpoint and returns all validation errors.
+func (s *Endpoint) Validate() error {
+	var errs verr.ValidationErrors
+
+	if !vgenIsUUID(s.ID) {
+		errs = append(errs, &verr.FieldError{
+			Path: "ID", Field: "ID", Rule: "uuid", Value: s.ID,
+			Msg: "is not a valid UUID",
+		})
+	}
+	if !vgenIsURL(s.Homepage) {
+		errs = append(errs, &verr.FieldError{
+			Path: "Homepage", Field: "Homepage", Rule: "url", Value: s.Homepage,
+			Msg: "is not a valid URL",
+		})
+	}
+	if !vgenIsURI(s.Contact) {
+		errs = append(errs, &verr.FieldError{
+			Path: "Contact", Field: "Contact", Rule: "uri", Value: s.Contact,
+			Msg: "is not a valid URI",
+		})
+	}
+	if !vgenIsHostname(s.Host) {
+		errs = append(errs, &verr.FieldError{
+			Path: "Host", Field: "Host", Rule: "hostname", Value: s.Host,
+			Msg: "is not a valid hostname",
+		})
+	}
+	if !vgenIsIP(s.Addr) {
+		errs = append(errs, &verr.FieldError{
+			Path: "Addr", Field: "Addr", Rule: "ip", Value: s.Addr,
+			Msg: "is not a valid IP address",
+		})
+	}
+	if !vgenIsIPv4(s.Gateway) {
+		errs = append(errs, &verr.FieldError{
+			Path: "Gateway", Field: "Gateway", Rule: "ipv4", Value: s.Gateway,
+			Msg: "is not a valid IPv4 address",
+		})
+	}
+	if s.Gateway6 != "" {
+		if !vgenIsIPv6(s.Gateway6) {
+			errs = append(errs, &verr.FieldError{
+				Path: "Gateway6", Field: "Gateway6", Rule: "ipv6", Value: s.Gateway6,
+				Msg: "is not a valid IPv6 address",
+			})
+		}
+	}
+	if !vgenIsCIDR(s.Subnet) {
+		errs = append(errs, &verr.FieldError{
+			Path: "Subnet", Field: "Subnet", Rule: "cidr", Value: s.Subnet,
+			Msg: "is not a valid CIDR prefix",
+		})
+	}
+	if !vgenIsMAC(s.HWAddr) {
+		errs = append(errs, &verr.FieldError{
+			Path: "HWAddr", Field: "HWAddr", Rule: "mac", Value: s.HWAddr,
+			Msg: "is not a valid MAC address",
+		})
+	}
+	for i, v := range s.Mirrors {
+		if !vgenIsURL(v) {
+			errs = append(errs, &verr.FieldError{
+				Path: verr.Index("Mirrors", i), Field: "Mirrors", Rule: "url", Value: v,
+				Msg: "is not a valid URL",
+			})
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
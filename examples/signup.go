@@ -0,0 +1,37 @@
+// examples/signup.go
+package main
+
+import "time"
+
+// Signup 演示跨字段比较规则
+type Signup struct {
+	Username        string `vgen:"required,nefield=Password"`
+	Password        string `vgen:"required,min=8"`
+	ConfirmPassword string `vgen:"eqfield=Password"`
+}
+
+// Booking 演示日期区间和数值区间
+type Booking struct {
+	StartAt   time.Time `vgen:"required"`
+	EndAt     time.Time `vgen:"gtfield=StartAt"`
+	MinGuests int       `vgen:"min=1"`
+	MaxGuests int       `vgen:"gtefield=MinGuests,ltefield=Capacity"`
+	Capacity  int
+	Code      SKU `vgen:"nefield=OldCode"` // 底层类型相同的命名类型可以比较
+	OldCode   string
+}
+
+// Customer 演示条件必填规则
+type Customer struct {
+	Type        string `vgen:"required,in=person|company"`
+	CompanyName string `vgen:"required_if=Type:company"`
+	VATNumber   string `vgen:"required_if=Type:company|Country:DE"`
+	Country     string
+	BirthDate   time.Time `vgen:"required_unless=Type:company"`
+	Email       string    `vgen:"required_without=Phone"`
+	Phone       string    `vgen:"required_without=Email"`
+	Extension   int       `vgen:"required_with=Phone|Fax"`
+	Fax         *string
+	Verified    bool `vgen:"required_if=Level:3"`
+	Level       uint8
+}
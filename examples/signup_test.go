@@ -0,0 +1,96 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSignupCrossField(t *testing.T) {
+	valid := &Signup{Username: "alice", Password: "s3cret-pass", ConfirmPassword: "s3cret-pass"}
+	if err := valid.Validate(); err != nil {
+		t.Fatalf("Unexpected validation error for valid signup: %v", err)
+	}
+
+	invalid := &Signup{Username: "s3cret-pass", Password: "s3cret-pass", ConfirmPassword: "typo"}
+	got := rulesOf(t, invalid.Validate())
+	want := []string{"ConfirmPassword:eqfield", "Username:nefield"}
+	if len(got) != len(want) || got[0] != want[0] || got[1] != want[1] {
+		t.Errorf("Validate() errors = %q, want %q", got, want)
+	}
+}
+
+func TestBookingCrossField(t *testing.T) {
+	start := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	valid := &Booking{
+		StartAt:   start,
+		EndAt:     start.Add(time.Hour),
+		MinGuests: 2,
+		MaxGuests: 2,
+		Capacity:  4,
+		Code:      "SKU-2",
+		OldCode:   "SKU-1",
+	}
+	if err := valid.Validate(); err != nil {
+		t.Fatalf("Unexpected validation error for valid booking: %v", err)
+	}
+
+	invalid := &Booking{
+		StartAt:   start,
+		EndAt:     start.In(time.FixedZone("UTC+8", 8*3600)), // 同一时刻，不晚于 StartAt
+		MinGuests: 3,
+		MaxGuests: 5,
+		Capacity:  4,
+		Code:      "SKU-1",
+		OldCode:   "SKU-1",
+	}
+	got := rulesOf(t, invalid.Validate())
+	want := []string{"Code:nefield", "EndAt:gtfield", "MaxGuests:ltefield"}
+	if len(got) != len(want) {
+		t.Fatalf("Validate() errors = %q, want %q", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Validate() errors = %q, want %q", got, want)
+			break
+		}
+	}
+
+	invalid.MaxGuests = 1
+	got = rulesOf(t, invalid.Validate())
+	if got[2] != "MaxGuests:gtefield" {
+		t.Errorf("Validate() errors = %q, want MaxGuests:gtefield", got)
+	}
+}
+
+func TestCustomerConditionalRequired(t *testing.T) {
+	person := &Customer{Type: "person", BirthDate: time.Date(1990, 5, 1, 0, 0, 0, 0, time.UTC), Email: "a@example.com"}
+	if err := person.Validate(); err != nil {
+		t.Fatalf("Unexpected validation error for person: %v", err)
+	}
+
+	fax := "+49 30 1234567"
+	company := &Customer{Type: "company", Country: "DE", Fax: &fax, Level: 3}
+	got := rulesOf(t, company.Validate())
+	want := []string{
+		"CompanyName:required_if",
+		"Email:required_without",
+		"Extension:required_with",
+		"Phone:required_without",
+		"VATNumber:required_if",
+		"Verified:required_if",
+	}
+	if len(got) != len(want) {
+		t.Fatalf("Validate() errors = %q, want %q", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Validate() errors = %q, want %q", got, want)
+			break
+		}
+	}
+
+	company = &Customer{Type: "company", CompanyName: "ACME", Country: "FR", Phone: "123", Extension: 7}
+	if err := company.Validate(); err != nil {
+		t.Errorf("Unexpected validation error for company: %v", err)
+	}
+}
@@ -0,0 +1,164 @@
+// Code generated by VGen. DO NOT EDIT.
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/hiramkuang/vgen/verr"
+)
+
+// Validate checks the fields of Signup and returns all validation errors.
+func (s *Signup) Validate() error {
+	var errs verr.ValidationErrors
+
+	if s.Username == "" {
+		errs = append(errs, &verr.FieldError{
+			Path: "Username", Field: "Username", Rule: "required", Value: s.Username,
+			Msg: "is required",
+		})
+	}
+	if s.Username == s.Password {
+		errs = append(errs, &verr.FieldError{
+			Path: "Username", Field: "Username", Rule: "nefield", Param: "Password", Value: s.Username,
+			Msg: "must not be equal to Password",
+		})
+	}
+	if s.Password == "" {
+		errs = append(errs, &verr.FieldError{
+			Path: "Password", Field: "Password", Rule: "required", Value: s.Password,
+			Msg: "is required",
+		})
+	}
+	if len(s.Password) < 8 {
+		errs = append(errs, &verr.FieldError{
+			Path: "Password", Field: "Password", Rule: "min", Param: "8", Value: s.Password,
+			Msg: fmt.Sprintf("length must be at least %d, got %d", 8, len(s.Password)),
+		})
+	}
+	if s.ConfirmPassword != s.Password {
+		errs = append(errs, &verr.FieldError{
+			Path: "ConfirmPassword", Field: "ConfirmPassword", Rule: "eqfield", Param: "Password", Value: s.ConfirmPassword,
+			Msg: "must be equal to Password",
+		})
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// Validate checks the fields of Booking and returns all validation errors.
+func (s *Booking) Validate() error {
+	var errs verr.ValidationErrors
+
+	if s.StartAt.IsZero() {
+		errs = append(errs, &verr.FieldError{
+			Path: "StartAt", Field: "StartAt", Rule: "required", Value: s.StartAt,
+			Msg: "is required",
+		})
+	}
+	if !s.EndAt.After(s.StartAt) {
+		errs = append(errs, &verr.FieldError{
+			Path: "EndAt", Field: "EndAt", Rule: "gtfield", Param: "StartAt", Value: s.EndAt,
+			Msg: "must be greater than StartAt",
+		})
+	}
+	if s.MinGuests < 1 {
+		errs = append(errs, &verr.FieldError{
+			Path: "MinGuests", Field: "MinGuests", Rule: "min", Param: "1", Value: s.MinGuests,
+			Msg: fmt.Sprintf("must be at least 1, got %v", s.MinGuests),
+		})
+	}
+	if s.MaxGuests < s.MinGuests {
+		errs = append(errs, &verr.FieldError{
+			Path: "MaxGuests", Field: "MaxGuests", Rule: "gtefield", Param: "MinGuests", Value: s.MaxGuests,
+			Msg: "must be greater than or equal to MinGuests",
+		})
+	}
+	if s.MaxGuests > s.Capacity {
+		errs = append(errs, &verr.FieldError{
+			Path: "MaxGuests", Field: "MaxGuests", Rule: "ltefield", Param: "Capacity", Value: s.MaxGuests,
+			Msg: "must be less than or equal to Capacity",
+		})
+	}
+	if s.Code == SKU(s.OldCode) {
+		errs = append(errs, &verr.FieldError{
+			Path: "Code", Field: "Code", Rule: "nefield", Param: "OldCode", Value: s.Code,
+			Msg: "must not be equal to OldCode",
+		})
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// Validate checks the fields of Customer and returns all validation errors.
+func (s *Customer) Validate() error {
+	var errs verr.ValidationErrors
+
+	if s.Type == "" {
+		errs = append(errs, &verr.FieldError{
+			Path: "Type", Field: "Type", Rule: "required", Value: s.Type,
+			Msg: "is required",
+		})
+	}
+	switch s.Type {
+	case "person", "company":
+	default:
+		errs = append(errs, &verr.FieldError{
+			Path: "Type", Field: "Type", Rule: "in", Param: "person|company", Value: s.Type,
+			Msg: fmt.Sprintf("value '%v' is not in the allowed list [%s]", s.Type, "person, company"),
+		})
+	}
+	if s.Type == "company" && s.CompanyName == "" {
+		errs = append(errs, &verr.FieldError{
+			Path: "CompanyName", Field: "CompanyName", Rule: "required_if", Param: "Type:company", Value: s.CompanyName,
+			Msg: "is required when Type is company",
+		})
+	}
+	if s.Type == "company" && s.Country == "DE" && s.VATNumber == "" {
+		errs = append(errs, &verr.FieldError{
+			Path: "VATNumber", Field: "VATNumber", Rule: "required_if", Param: "Type:company|Country:DE", Value: s.VATNumber,
+			Msg: "is required when Type is company and Country is DE",
+		})
+	}
+	if s.Type != "company" && s.BirthDate.IsZero() {
+		errs = append(errs, &verr.FieldError{
+			Path: "BirthDate", Field: "BirthDate", Rule: "required_unless", Param: "Type:company", Value: s.BirthDate,
+			Msg: "is required unless Type is company",
+		})
+	}
+	if s.Phone == "" && s.Email == "" {
+		errs = append(errs, &verr.FieldError{
+			Path: "Email", Field: "Email", Rule: "required_without", Param: "Phone", Value: s.Email,
+			Msg: "is required when Phone is absent",
+		})
+	}
+	if s.Email == "" && s.Phone == "" {
+		errs = append(errs, &verr.FieldError{
+			Path: "Phone", Field: "Phone", Rule: "required_without", Param: "Email", Value: s.Phone,
+			Msg: "is required when Email is absent",
+		})
+	}
+	if (s.Phone != "" || s.Fax != nil) && s.Extension == 0 {
+		errs = append(errs, &verr.FieldError{
+			Path: "Extension", Field: "Extension", Rule: "required_with", Param: "Phone|Fax", Value: s.Extension,
+			Msg: "is required when Phone or Fax is present",
+		})
+	}
+	if s.Level == 3 && !s.Verified {
+		errs = append(errs, &verr.FieldError{
+			Path: "Verified", Field: "Verified", Rule: "required_if", Param: "Level:3", Value: s.Verified,
+			Msg: "is required when Level is 3",
+		})
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
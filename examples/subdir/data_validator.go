@@ -0,0 +1,24 @@
+// Code generated by VGen. DO NOT EDIT.
+
+package subdir
+
+import (
+	"github.com/hiramkuang/vgen/verr"
+)
+
+// Validate checks the fields of Data and returns all validation errors.
+func (s *Data) Validate() error {
+	var errs verr.ValidationErrors
+
+	if s.Value == "" {
+		errs = append(errs, &verr.FieldError{
+			Path: "Value", Field: "Value", Rule: "required", Value: s.Value,
+			Msg: "is required",
+		})
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
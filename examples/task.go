@@ -0,0 +1,46 @@
+// examples/task.go
+package main
+
+// Priority 是任务优先级，用于演示 in 规则引用同类型的常量
+type Priority int
+
+const (
+	PriorityLow Priority = iota + 1
+	PriorityMedium
+	PriorityHigh
+)
+
+// Stage 是任务所处的阶段
+type Stage string
+
+const (
+	StageTodo Stage = "todo"
+	StageDone Stage = "done"
+)
+
+// Task 演示整数、命名类型和常量上的 in 规则
+type Task struct {
+	Priority Priority `vgen:"in=PriorityLow|PriorityMedium|PriorityHigh"`
+	Stage    Stage    `vgen:"in=StageTodo|StageDone|blocked"` // 常量和字面量可以混用
+	Level    uint8    `vgen:"in=1|2|0x4"`
+	Retries  int      `vgen:"omitempty,in=-1|3|5"`
+	Weights  []int    `vgen:"dive,in=10|20"`
+}
+
+// Severity 的合法值完全由下面的常量决定
+type Severity string
+
+const (
+	SeverityInfo    Severity = "info"
+	SeverityWarning Severity = "warning"
+	SeverityError   Severity = "error"
+	SeverityDefault          = SeverityInfo // 值重复的常量不会生成重复的 case
+)
+
+// Incident 演示 enum 规则：允许的值从 Severity 和 Priority 的常量自动推导
+type Incident struct {
+	Severity  Severity   `vgen:"required,enum"`
+	Priority  Priority   `vgen:"enum"`
+	Escalated []Priority `vgen:"dive,enum"`
+	Override  *Severity  `vgen:"enum"`
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hiramkuang/vgen/verr"
+)
+
+func TestTaskIn(t *testing.T) {
+	valid := &Task{Priority: PriorityHigh, Stage: "blocked", Level: 4, Retries: -1, Weights: []int{10, 20}}
+	if err := valid.Validate(); err != nil {
+		t.Fatalf("Unexpected validation error for valid task: %v", err)
+	}
+
+	invalid := &Task{Priority: 0, Stage: "doing", Level: 3, Retries: 4, Weights: []int{10, 15}}
+	var errs verr.ValidationErrors
+	if err := invalid.Validate(); !errors.As(err, &errs) {
+		t.Fatalf("Expected verr.ValidationErrors, got %v", err)
+	}
+	want := []string{"Priority", "Stage", "Level", "Retries", "Weights[1]"}
+	if len(errs) != len(want) {
+		t.Fatalf("Expected %d field errors, got %d: %v", len(want), len(errs), errs)
+	}
+	for i, w := range want {
+		if errs[i].Path != w || errs[i].Rule != "in" {
+			t.Errorf("errs[%d] = %s:%s, want %s:in", i, errs[i].Path, errs[i].Rule, w)
+		}
+	}
+	if msg := errs[2].Msg; msg != "value '3' is not in the allowed list [1, 2, 0x4]" {
+		t.Errorf("Level error Msg = %q", msg)
+	}
+}
+
+func TestIncidentEnum(t *testing.T) {
+	override := SeverityError
+	valid := &Incident{Severity: SeverityDefault, Priority: PriorityLow, Escalated: []Priority{PriorityHigh}, Override: &override}
+	if err := valid.Validate(); err != nil {
+		t.Fatalf("Unexpected validation error for valid incident: %v", err)
+	}
+
+	bogus := Severity("fatal")
+	invalid := &Incident{Severity: "debug", Priority: 4, Escalated: []Priority{PriorityMedium, 0}, Override: &bogus}
+	var errs verr.ValidationErrors
+	if err := invalid.Validate(); !errors.As(err, &errs) {
+		t.Fatalf("Expected verr.ValidationErrors, got %v", err)
+	}
+	want := []struct{ path, msg string }{
+		{"Severity", "value 'debug' is not a valid Severity"},
+		{"Priority", "value '4' is not a valid Priority"},
+		{"Escalated[1]", "value '0' is not a valid Priority"},
+		{"Override", "value 'fatal' is not a valid Severity"},
+	}
+	if len(errs) != len(want) {
+		t.Fatalf("Expected %d field errors, got %d: %v", len(want), len(errs), errs)
+	}
+	for i, w := range want {
+		if errs[i].Path != w.path || errs[i].Rule != "enum" || errs[i].Msg != w.msg {
+			t.Errorf("errs[%d] = {%q, %q, %q}, want {%q, enum, %q}", i, errs[i].Path, errs[i].Rule, errs[i].Msg, w.path, w.msg)
+		}
+	}
+}
@@ -0,0 +1,117 @@
+// Code generated by VGen. DO NOT EDIT.
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/hiramkuang/vgen/verr"
+)
+
+// Validate checks the fields of Task and returns all validation errors.
+func (s *Task) Validate() error {
+	var errs verr.ValidationErrors
+
+	switch s.Priority {
+	case PriorityLow, PriorityMedium, PriorityHigh:
+	default:
+		errs = append(errs, &verr.FieldError{
+			Path: "Priority", Field: "Priority", Rule: "in", Param: "PriorityLow|PriorityMedium|PriorityHigh", Value: s.Priority,
+			Msg: fmt.Sprintf("value '%v' is not in the allowed list [%s]", s.Priority, "PriorityLow, PriorityMedium, PriorityHigh"),
+		})
+	}
+	switch s.Stage {
+	case StageTodo, StageDone, "blocked":
+	default:
+		errs = append(errs, &verr.FieldError{
+			Path: "Stage", Field: "Stage", Rule: "in", Param: "StageTodo|StageDone|blocked", Value: s.Stage,
+			Msg: fmt.Sprintf("value '%v' is not in the allowed list [%s]", s.Stage, "StageTodo, StageDone, blocked"),
+		})
+	}
+	switch s.Level {
+	case 1, 2, 4:
+	default:
+		errs = append(errs, &verr.FieldError{
+			Path: "Level", Field: "Level", Rule: "in", Param: "1|2|0x4", Value: s.Level,
+			Msg: fmt.Sprintf("value '%v' is not in the allowed list [%s]", s.Level, "1, 2, 0x4"),
+		})
+	}
+	if s.Retries != 0 {
+		switch s.Retries {
+		case -1, 3, 5:
+		default:
+			errs = append(errs, &verr.FieldError{
+				Path: "Retries", Field: "Retries", Rule: "in", Param: "-1|3|5", Value: s.Retries,
+				Msg: fmt.Sprintf("value '%v' is not in the allowed list [%s]", s.Retries, "-1, 3, 5"),
+			})
+		}
+	}
+	for i, v := range s.Weights {
+		switch v {
+		case 10, 20:
+		default:
+			errs = append(errs, &verr.FieldError{
+				Path: verr.Index("Weights", i), Field: "Weights", Rule: "in", Param: "10|20", Value: v,
+				Msg: fmt.Sprintf("value '%v' is not in the allowed list [%s]", v, "10, 20"),
+			})
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// Validate checks the fields of Incident and returns all validation errors.
+func (s *Incident) Validate() error {
+	var errs verr.ValidationErrors
+
+	if s.Severity == "" {
+		errs = append(errs, &verr.FieldError{
+			Path: "Severity", Field: "Severity", Rule: "required", Value: s.Severity,
+			Msg: "is required",
+		})
+	}
+	switch s.Severity {
+	case SeverityInfo, SeverityWarning, SeverityError:
+	default:
+		errs = append(errs, &verr.FieldError{
+			Path: "Severity", Field: "Severity", Rule: "enum", Value: s.Severity,
+			Msg: fmt.Sprintf("value '%v' is not a valid Severity", s.Severity),
+		})
+	}
+	switch s.Priority {
+	case PriorityLow, PriorityMedium, PriorityHigh:
+	default:
+		errs = append(errs, &verr.FieldError{
+			Path: "Priority", Field: "Priority", Rule: "enum", Value: s.Priority,
+			Msg: fmt.Sprintf("value '%v' is not a valid Priority", s.Priority),
+		})
+	}
+	for i, v := range s.Escalated {
+		switch v {
+		case PriorityLow, PriorityMedium, PriorityHigh:
+		default:
+			errs = append(errs, &verr.FieldError{
+				Path: verr.Index("Escalated", i), Field: "Escalated", Rule: "enum", Value: v,
+				Msg: fmt.Sprintf("value '%v' is not a valid Priority", v),
+			})
+		}
+	}
+	if s.Override != nil {
+		switch *s.Override {
+		case SeverityInfo, SeverityWarning, SeverityError:
+		default:
+			errs = append(errs, &verr.FieldError{
+				Path: "Override", Field: "Override", Rule: "enum", Value: *s.Override,
+				Msg: fmt.Sprintf("value '%v' is not a valid Severity", *s.Override),
+			})
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
@@ -8,6 +8,10 @@ type User struct {
 	Email  string `vgen:"required,email"`
 	Age    int    `vgen:"required,min=0,max=150"`
 	City   string `vgen:"len=5"`                      // 城市名必须是5个字符
-	Status string `vgen:"in=active,pending,disabled"` // 状态只能是这三个值之一
+	Status string `vgen:"in=active|pending|disabled"` // 状态只能是这三个值之一
+	// omitempty 之后的规则只在字段非零值时检查
+	Backup  string   `vgen:"omitempty,email"`
+	Score   int      `vgen:"omitempty,min=10,max=20"`
+	Aliases []string `vgen:"omitempty,max=3,dive,omitempty,min=2"` // 空切片和空字符串元素都会被跳过
 	// 可以添加更多字段和规则进行测试
 }
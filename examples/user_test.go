@@ -1,7 +1,10 @@
 package main
 
 import (
+	"errors"
 	"testing"
+
+	"github.com/hiramkuang/vgen/verr"
 )
 
 func TestUserValidation(t *testing.T) {
@@ -12,7 +15,7 @@ func TestUserValidation(t *testing.T) {
 			Email:  "alice@example.com",
 			Age:    30,
 			City:   "Tokyo",  // 5个字符, 符合 len=5
-			Status: "active", // 在 in=active,pending,disabled 列表中
+			Status: "active", // 在 in=active|pending|disabled 列表中
 		}
 		if err := validUser.Validate(); err != nil {
 			t.Errorf("Unexpected validation error for validUser: %v", err)
@@ -82,7 +85,7 @@ func TestUserValidation(t *testing.T) {
 			Email:  "charlie@example.com",
 			Age:    35,
 			City:   "Paris",    // 长度为 5，符合 len=5
-			Status: "archived", // 不在 in=active,pending,disabled 列表中
+			Status: "archived", // 不在 in=active|pending|disabled 列表中
 		}
 		if err := userInvalidIn.Validate(); err == nil {
 			t.Error("Expected validation error for userInvalidIn (in rule), but got none")
@@ -96,10 +99,84 @@ func TestUserValidation(t *testing.T) {
 			Email:  "diana@example.com",
 			Age:    28,
 			City:   "Milan",   // 长度为 5，符合 len=5
-			Status: "pending", // 在 in=active,pending,disabled 列表中
+			Status: "pending", // 在 in=active|pending|disabled 列表中
 		}
 		if err := userAllValid.Validate(); err != nil {
 			t.Errorf("Unexpected validation error for userAllValid: %v", err)
 		}
 	})
-}
\ No newline at end of file
+}
+
+func TestUserValidationErrors(t *testing.T) {
+	user := &User{
+		Name:   "A",             // 违反 min=2
+		Email:  "invalid-email", // 违反 email
+		Age:    30,
+		City:   "Tokyo",
+		Status: "archived", // 违反 in
+	}
+
+	err := user.Validate()
+	var errs verr.ValidationErrors
+	if !errors.As(err, &errs) {
+		t.Fatalf("Expected verr.ValidationErrors, got %T: %v", err, err)
+	}
+
+	want := []struct{ path, rule, param string }{
+		{"Name", "min", "2"},
+		{"Email", "email", ""},
+		{"Status", "in", "active|pending|disabled"},
+	}
+	if len(errs) != len(want) {
+		t.Fatalf("Expected %d field errors, got %d: %v", len(want), len(errs), err)
+	}
+	for i, w := range want {
+		if errs[i].Path != w.path || errs[i].Rule != w.rule || errs[i].Param != w.param {
+			t.Errorf("errs[%d] = {Path: %q, Rule: %q, Param: %q}, want {%q, %q, %q}",
+				i, errs[i].Path, errs[i].Rule, errs[i].Param, w.path, w.rule, w.param)
+		}
+	}
+	if errs[0].Value != "A" {
+		t.Errorf("errs[0].Value = %v, want %q", errs[0].Value, "A")
+	}
+
+	var fe *verr.FieldError
+	if !errors.As(err, &fe) || fe.Field != "Name" {
+		t.Errorf("errors.As(*verr.FieldError) = %v, want the Name error", fe)
+	}
+}
+
+func TestUserOmitEmpty(t *testing.T) {
+	base := User{Name: "Diana", Email: "diana@example.com", Age: 28, City: "Milan", Status: "active"}
+
+	// 可选字段为零值时跳过其余规则
+	if err := base.Validate(); err != nil {
+		t.Fatalf("Unexpected validation error with empty optional fields: %v", err)
+	}
+
+	set := base
+	set.Backup = "diana.backup@example.com"
+	set.Score = 15
+	set.Aliases = []string{"dd", ""}
+	if err := set.Validate(); err != nil {
+		t.Errorf("Unexpected validation error with valid optional fields: %v", err)
+	}
+
+	bad := base
+	bad.Backup = "not-an-email"
+	bad.Score = 5
+	bad.Aliases = []string{"d", "", "dd", "ddd"}
+	var errs verr.ValidationErrors
+	if err := bad.Validate(); !errors.As(err, &errs) {
+		t.Fatalf("Expected verr.ValidationErrors, got %v", err)
+	}
+	want := []string{"Backup:email", "Score:min", "Aliases:max", "Aliases[0]:min"}
+	if len(errs) != len(want) {
+		t.Fatalf("Expected %d field errors, got %d: %v", len(want), len(errs), errs)
+	}
+	for i, w := range want {
+		if got := errs[i].Path + ":" + errs[i].Rule; got != w {
+			t.Errorf("errs[%d] = %s, want %s", i, got, w)
+		}
+	}
+}
@@ -4,70 +4,119 @@ package main
 
 import (
 	"fmt"
-	"regexp"
-)
-
-// isEmailValid checks if the email is valid (simple regex).
-var emailRegex = regexp.MustCompile(`^[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\.[a-zA-Z]{2,}$`)
-
-func isEmailValid(e string) bool {
-	return emailRegex.MatchString(e)
-}
 
+	"github.com/hiramkuang/vgen/verr"
+)
 
 // Validate checks the fields of User and returns all validation errors.
 func (s *User) Validate() error {
-	var errs []error // 用于累积错误
+	var errs verr.ValidationErrors
 
-	
-	
-	if s.Name == "" { errs = append(errs, fmt.Errorf("field %s is required", "Name")) }
-	
-	if len(s.Name) < 2 { errs = append(errs, fmt.Errorf("field %s length must be at least %d, got %d", "Name", 2, len(s.Name))) }
-	
-	if len(s.Name) > 50 { errs = append(errs, fmt.Errorf("field %s length must be at most %d, got %d", "Name", 50, len(s.Name))) }
-	
-	
-	
-	if s.Email == "" { errs = append(errs, fmt.Errorf("field %s is required", "Email")) }
-	
-	if !isEmailValid(s.Email) { errs = append(errs, fmt.Errorf("field %s is not a valid email", "Email")) }
-	
-	
-	
-	if s.Age == 0 { errs = append(errs, fmt.Errorf("field %s is required", "Age")) }
-	
-	if s.Age < 0 { errs = append(errs, fmt.Errorf("field %s must be at least %d, got %d", "Age", 0, s.Age)) }
-	
-	if s.Age > 150 { errs = append(errs, fmt.Errorf("field %s must be at most %d, got %d", "Age", 150, s.Age)) }
-	
-	
-	
-	if len(s.City) != 5 { errs = append(errs, fmt.Errorf("field %s length must be %d, got %d", "City", 5, len(s.City))) }
-	
-	
-	
-	
-{
-	allowedValues := map[string]bool{"active": true,}
-	if !allowedValues[s.Status] {
-		errs = append(errs, fmt.Errorf("field %s value '%s' is not in the allowed list [%s]", "Status", s.Status, "active"))
+	if s.Name == "" {
+		errs = append(errs, &verr.FieldError{
+			Path: "Name", Field: "Name", Rule: "required", Value: s.Name,
+			Msg: "is required",
+		})
+	}
+	if len(s.Name) < 2 {
+		errs = append(errs, &verr.FieldError{
+			Path: "Name", Field: "Name", Rule: "min", Param: "2", Value: s.Name,
+			Msg: fmt.Sprintf("length must be at least %d, got %d", 2, len(s.Name)),
+		})
+	}
+	if len(s.Name) > 50 {
+		errs = append(errs, &verr.FieldError{
+			Path: "Name", Field: "Name", Rule: "max", Param: "50", Value: s.Name,
+			Msg: fmt.Sprintf("length must be at most %d, got %d", 50, len(s.Name)),
+		})
+	}
+	if s.Email == "" {
+		errs = append(errs, &verr.FieldError{
+			Path: "Email", Field: "Email", Rule: "required", Value: s.Email,
+			Msg: "is required",
+		})
+	}
+	if !vgenIsEmailValid(s.Email) {
+		errs = append(errs, &verr.FieldError{
+			Path: "Email", Field: "Email", Rule: "email", Value: s.Email,
+			Msg: "is not a valid email",
+		})
+	}
+	if s.Age == 0 {
+		errs = append(errs, &verr.FieldError{
+			Path: "Age", Field: "Age", Rule: "required", Value: s.Age,
+			Msg: "is required",
+		})
+	}
+	if s.Age < 0 {
+		errs = append(errs, &verr.FieldError{
+			Path: "Age", Field: "Age", Rule: "min", Param: "0", Value: s.Age,
+			Msg: fmt.Sprintf("must be at least 0, got %v", s.Age),
+		})
+	}
+	if s.Age > 150 {
+		errs = append(errs, &verr.FieldError{
+			Path: "Age", Field: "Age", Rule: "max", Param: "150", Value: s.Age,
+			Msg: fmt.Sprintf("must be at most 150, got %v", s.Age),
+		})
+	}
+	if len(s.City) != 5 {
+		errs = append(errs, &verr.FieldError{
+			Path: "City", Field: "City", Rule: "len", Param: "5", Value: s.City,
+			Msg: fmt.Sprintf("length must be %d, got %d", 5, len(s.City)),
+		})
+	}
+	switch s.Status {
+	case "active", "pending", "disabled":
+	default:
+		errs = append(errs, &verr.FieldError{
+			Path: "Status", Field: "Status", Rule: "in", Param: "active|pending|disabled", Value: s.Status,
+			Msg: fmt.Sprintf("value '%v' is not in the allowed list [%s]", s.Status, "active, pending, disabled"),
+		})
+	}
+	if s.Backup != "" {
+		if !vgenIsEmailValid(s.Backup) {
+			errs = append(errs, &verr.FieldError{
+				Path: "Backup", Field: "Backup", Rule: "email", Value: s.Backup,
+				Msg: "is not a valid email",
+			})
+		}
+	}
+	if s.Score != 0 {
+		if s.Score < 10 {
+			errs = append(errs, &verr.FieldError{
+				Path: "Score", Field: "Score", Rule: "min", Param: "10", Value: s.Score,
+				Msg: fmt.Sprintf("must be at least 10, got %v", s.Score),
+			})
+		}
+		if s.Score > 20 {
+			errs = append(errs, &verr.FieldError{
+				Path: "Score", Field: "Score", Rule: "max", Param: "20", Value: s.Score,
+				Msg: fmt.Sprintf("must be at most 20, got %v", s.Score),
+			})
+		}
+	}
+	if len(s.Aliases) != 0 {
+		if len(s.Aliases) > 3 {
+			errs = append(errs, &verr.FieldError{
+				Path: "Aliases", Field: "Aliases", Rule: "max", Param: "3", Value: s.Aliases,
+				Msg: fmt.Sprintf("length must be at most %d, got %d", 3, len(s.Aliases)),
+			})
+		}
+		for i, v := range s.Aliases {
+			if v != "" {
+				if len(v) < 2 {
+					errs = append(errs, &verr.FieldError{
+						Path: verr.Index("Aliases", i), Field: "Aliases", Rule: "min", Param: "2", Value: v,
+						Msg: fmt.Sprintf("length must be at least %d, got %d", 2, len(v)),
+					})
+				}
+			}
+		}
 	}
-}
-	
-	// TODO: Implement validation for rule 'pending'
-	
-	// TODO: Implement validation for rule 'disabled'
-	
-	
 
-	// 如果有错误，则返回它们
 	if len(errs) > 0 {
-		// 这里简单地返回第一个错误。更复杂的实现可以格式化所有错误。
-		// 例如，可以返回 fmt.Errorf("validation failed: %v", errs)
-		// 或者定义一个包含 []error 的自定义错误类型。
-		return errs[0] 
+		return errs
 	}
 	return nil
 }
-
@@ -0,0 +1,405 @@
+// Code generated by VGen. DO NOT EDIT.
+
+package main
+
+import (
+	"cmp"
+	"encoding/base64"
+	"encoding/json"
+	"net"
+	"net/netip"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Precompiled regular expressions used by pattern rules.
+var (
+	vgenPattern0 = regexp.MustCompile(`^[A-Z]{3}-\d+$`)
+	vgenPattern1 = regexp.MustCompile(`^(north|south)-[0-9]{2}$`)
+	vgenPattern2 = regexp.MustCompile(`^[a-z]{2},[a-z]{2}$`)
+	vgenPattern3 = regexp.MustCompile(`^S\d{3}$`)
+)
+
+// vgenDuplicateMapValue visits the keys of m in ascending order and returns the
+// first key whose value equals the value of a smaller key, so the reported key
+// does not depend on map iteration order.
+func vgenDuplicateMapValue[K cmp.Ordered, V comparable](m map[K]V) (K, bool) {
+	keys := make([]K, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	seen := make(map[V]struct{}, len(m))
+	for _, k := range keys {
+		if _, ok := seen[m[k]]; ok {
+			return k, true
+		}
+		seen[m[k]] = struct{}{}
+	}
+	var zero K
+	return zero, false
+}
+
+// vgenFirstDuplicate returns the index of the first element of s that equals an
+// earlier element, or -1 if all elements are distinct. Short slices are
+// compared pairwise so that the common case does not allocate.
+func vgenFirstDuplicate[T comparable](s []T) int {
+	if len(s) <= 16 {
+		for i := 1; i < len(s); i++ {
+			for j := 0; j < i; j++ {
+				if s[i] == s[j] {
+					return i
+				}
+			}
+		}
+		return -1
+	}
+	seen := make(map[T]struct{}, len(s))
+	for i, e := range s {
+		if _, ok := seen[e]; ok {
+			return i
+		}
+		seen[e] = struct{}{}
+	}
+	return -1
+}
+
+// vgenFirstDuplicateBy is like vgenFirstDuplicate but compares the keys returned by key.
+func vgenFirstDuplicateBy[E any, K comparable](s []E, key func(E) K) int {
+	if len(s) <= 16 {
+		for i := 1; i < len(s); i++ {
+			k := key(s[i])
+			for j := 0; j < i; j++ {
+				if key(s[j]) == k {
+					return i
+				}
+			}
+		}
+		return -1
+	}
+	seen := make(map[K]struct{}, len(s))
+	for i, e := range s {
+		k := key(e)
+		if _, ok := seen[k]; ok {
+			return i
+		}
+		seen[k] = struct{}{}
+	}
+	return -1
+}
+
+// vgenHasDuplicateMapValue reports whether two keys of m have equal values.
+// It is used for maps whose keys have no order, where no stable key can be reported.
+func vgenHasDuplicateMapValue[K, V comparable](m map[K]V) bool {
+	seen := make(map[V]struct{}, len(m))
+	for _, v := range m {
+		if _, ok := seen[v]; ok {
+			return true
+		}
+		seen[v] = struct{}{}
+	}
+	return false
+}
+
+// vgenIsASCII reports whether s contains only ASCII characters.
+func vgenIsASCII(s string) bool {
+	for _, r := range s {
+		if r > unicode.MaxASCII {
+			return false
+		}
+	}
+	return true
+}
+
+// vgenIsAlpha reports whether s is non-empty and contains only ASCII letters.
+func vgenIsAlpha(s string) bool {
+	for _, r := range s {
+		if !('a' <= r && r <= 'z' || 'A' <= r && r <= 'Z') {
+			return false
+		}
+	}
+	return s != ""
+}
+
+// vgenIsAlphanum reports whether s is non-empty and contains only ASCII letters and digits.
+func vgenIsAlphanum(s string) bool {
+	for _, r := range s {
+		if !('a' <= r && r <= 'z' || 'A' <= r && r <= 'Z' || '0' <= r && r <= '9') {
+			return false
+		}
+	}
+	return s != ""
+}
+
+// vgenIsBase64 reports whether s is non-empty, valid padded standard base64 (RFC 4648 section 4).
+// Line breaks are rejected even though the decoder would skip them.
+func vgenIsBase64(s string) bool {
+	if s == "" || strings.ContainsAny(s, "\r\n") {
+		return false
+	}
+	_, err := base64.StdEncoding.DecodeString(s)
+	return err == nil
+}
+
+// vgenIsBase64URL reports whether s is non-empty, valid URL-safe base64 (RFC 4648 section 5),
+// with or without padding. Line breaks are rejected even though the decoder would skip them.
+func vgenIsBase64URL(s string) bool {
+	if s == "" || strings.ContainsAny(s, "\r\n") {
+		return false
+	}
+	enc := base64.URLEncoding
+	if len(s)%4 != 0 {
+		enc = base64.RawURLEncoding
+	}
+	_, err := enc.DecodeString(s)
+	return err == nil
+}
+
+// vgenIsCIDR reports whether s is an IP prefix in CIDR notation, such as 10.0.0.0/8.
+func vgenIsCIDR(s string) bool {
+	_, err := netip.ParsePrefix(s)
+	return err == nil
+}
+
+// vgenIsE164 reports whether s is a phone number in E.164 format: a "+" followed by
+// a country code and subscriber number of at most 15 digits, without a leading zero.
+func vgenIsE164(s string) bool {
+	if len(s) < 3 || len(s) > 16 || s[0] != '+' || s[1] == '0' {
+		return false
+	}
+	for i := 1; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// vgenEmailRegex is a simple pattern for email addresses.
+var vgenEmailRegex = regexp.MustCompile(`^[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\.[a-zA-Z]{2,}$`)
+
+// vgenIsEmailValid checks if the email is valid (simple regex).
+func vgenIsEmailValid(e string) bool {
+	return vgenEmailRegex.MatchString(e)
+}
+
+// vgenIsHex reports whether s is a non-empty, even-length string of hexadecimal digits.
+func vgenIsHex(s string) bool {
+	if s == "" || len(s)%2 != 0 {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if !('0' <= c && c <= '9' || 'a' <= c && c <= 'f' || 'A' <= c && c <= 'F') {
+			return false
+		}
+	}
+	return true
+}
+
+// vgenIsHostname reports whether s is a hostname as defined by RFC 1123:
+// dot-separated labels of 1 to 63 letters, digits or hyphens that do not
+// start or end with a hyphen, at most 253 characters in total.
+func vgenIsHostname(s string) bool {
+	s = strings.TrimSuffix(s, ".")
+	if s == "" || len(s) > 253 {
+		return false
+	}
+	for _, label := range strings.Split(s, ".") {
+		if label == "" || len(label) > 63 || label[0] == '-' || label[len(label)-1] == '-' {
+			return false
+		}
+		for i := 0; i < len(label); i++ {
+			c := label[i]
+			if !('0' <= c && c <= '9' || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || c == '-') {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// vgenIsIP reports whether s is an IPv4 or IPv6 address without a zone.
+func vgenIsIP(s string) bool {
+	addr, err := netip.ParseAddr(s)
+	return err == nil && addr.Zone() == ""
+}
+
+// vgenIsIPv4 reports whether s is an IPv4 address in dotted decimal form.
+func vgenIsIPv4(s string) bool {
+	addr, err := netip.ParseAddr(s)
+	return err == nil && addr.Is4()
+}
+
+// vgenIsIPv6 reports whether s is an IPv6 address without a zone.
+func vgenIsIPv6(s string) bool {
+	addr, err := netip.ParseAddr(s)
+	return err == nil && addr.Is6() && addr.Zone() == ""
+}
+
+// vgenIsJSON reports whether s is a valid JSON encoding.
+func vgenIsJSON(s string) bool {
+	return json.Valid([]byte(s))
+}
+
+// vgenIsLowercase reports whether s contains no uppercase letters.
+func vgenIsLowercase(s string) bool {
+	for _, r := range s {
+		if unicode.IsUpper(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// vgenIsMAC reports whether s is an IEEE 802 MAC-48, EUI-48, EUI-64 or
+// 20-octet IP over InfiniBand link-layer address.
+func vgenIsMAC(s string) bool {
+	_, err := net.ParseMAC(s)
+	return err == nil
+}
+
+// vgenIsNumeric reports whether s is non-empty and contains only ASCII digits.
+func vgenIsNumeric(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return s != ""
+}
+
+// vgenIsPrintASCII reports whether s contains only printable ASCII characters (space to tilde).
+func vgenIsPrintASCII(s string) bool {
+	for _, r := range s {
+		if r < ' ' || r > '~' {
+			return false
+		}
+	}
+	return true
+}
+
+// vgenIsSemver reports whether s is a semantic version as defined by SemVer 2.0.0,
+// such as 1.2.3, 1.0.0-rc.1 or 2.0.0+build.5. A leading "v" is not accepted.
+func vgenIsSemver(s string) bool {
+	core, build, hasBuild := strings.Cut(s, "+")
+	if hasBuild && !vgenSemverIdents(build, false) {
+		return false
+	}
+	core, pre, hasPre := strings.Cut(core, "-")
+	if hasPre && !vgenSemverIdents(pre, true) {
+		return false
+	}
+	parts := strings.Split(core, ".")
+	if len(parts) != 3 {
+		return false
+	}
+	for _, p := range parts {
+		if !vgenSemverNumber(p) {
+			return false
+		}
+	}
+	return true
+}
+
+// vgenSemverNumber reports whether s is a non-empty decimal number without leading zeros.
+func vgenSemverNumber(s string) bool {
+	if s == "" || len(s) > 1 && s[0] == '0' {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// vgenSemverIdents reports whether s is a dot-separated list of non-empty identifiers
+// made of ASCII letters, digits and hyphens. Numeric pre-release identifiers
+// must not have leading zeros.
+func vgenSemverIdents(s string, pre bool) bool {
+	for _, id := range strings.Split(s, ".") {
+		if id == "" {
+			return false
+		}
+		numeric := true
+		for i := 0; i < len(id); i++ {
+			c := id[i]
+			switch {
+			case '0' <= c && c <= '9':
+			case 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z', c == '-':
+				numeric = false
+			default:
+				return false
+			}
+		}
+		if pre && numeric && !vgenSemverNumber(id) {
+			return false
+		}
+	}
+	return true
+}
+
+// vgenIsURI reports whether s is an absolute URI, such as mailto:a@example.com.
+func vgenIsURI(s string) bool {
+	u, err := url.Parse(s)
+	return err == nil && u.Scheme != ""
+}
+
+// vgenIsURL reports whether s is an absolute URL with a scheme and a host.
+func vgenIsURL(s string) bool {
+	u, err := url.Parse(s)
+	return err == nil && u.Scheme != "" && u.Host != ""
+}
+
+// vgenIsUTF8 reports whether s consists entirely of valid UTF-8 encoded runes.
+func vgenIsUTF8(s string) bool {
+	return utf8.ValidString(s)
+}
+
+// vgenIsUUID reports whether s is a UUID in the canonical 8-4-4-4-12 hex form.
+func vgenIsUUID(s string) bool {
+	if len(s) != 36 {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch i {
+		case 8, 13, 18, 23:
+			if c != '-' {
+				return false
+			}
+		default:
+			if !('0' <= c && c <= '9' || 'a' <= c && c <= 'f' || 'A' <= c && c <= 'F') {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// vgenIsUppercase reports whether s contains no lowercase letters.
+func vgenIsUppercase(s string) bool {
+	for _, r := range s {
+		if unicode.IsLower(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// vgenIsWithin reports whether t is at most d before or after the current time.
+func vgenIsWithin(t time.Time, d time.Duration) bool {
+	diff := vgenNow().Sub(t)
+	return -d <= diff && diff <= d
+}
+
+// vgenNow returns the current time for the future, past and within rules.
+// Tests can replace it to make time-based validation deterministic.
+var vgenNow = time.Now
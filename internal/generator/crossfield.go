@@ -0,0 +1,161 @@
+package generator
+
+import (
+	"fmt"
+	"go/types"
+	"strings"
+
+	vgenparser "github.com/hiramkuang/vgen/internal/parser"
+)
+
+// crossFieldOps 把跨字段比较规则映射到校验失败的条件和错误描述
+var crossFieldOps = map[string]struct {
+	op      string // 校验失败的比较运算符，例如 eqfield 在 a != b 时失败
+	timeOp  string // time.Time 上校验失败的条件，%[1]s 为本字段，%[2]s 为被引用的字段
+	ordered bool   // 是否需要可排序的类型
+	desc    string
+}{
+	"eqfield":  {"!=", "!%s.Equal(%s)", false, "must be equal to"},
+	"nefield":  {"==", "%s.Equal(%s)", false, "must not be equal to"},
+	"gtfield":  {"<=", "!%s.After(%s)", true, "must be greater than"},
+	"gtefield": {"<", "%s.Before(%s)", true, "must be greater than or equal to"},
+	"ltfield":  {">=", "!%s.Before(%s)", true, "must be less than"},
+	"ltefield": {">", "%s.After(%s)", true, "must be less than or equal to"},
+}
+
+// lookupField 在当前结构体中查找名为 name 的字段（包括通过非指针嵌入提升的字段），
+// 返回其访问表达式和类型
+func (f *file) lookupField(name string) (string, types.Type, error) {
+	if name == "" || strings.Contains(name, ".") {
+		return "", nil, fmt.Errorf("invalid field reference %q: must be the name of a sibling field", name)
+	}
+	obj, _, indirect := types.LookupFieldOrMethod(f.cur, false, f.pkg, name)
+	field, ok := obj.(*types.Var)
+	if !ok || !field.IsField() {
+		return "", nil, fmt.Errorf("referenced field %s does not exist", name)
+	}
+	if indirect {
+		return "", nil, fmt.Errorf("referenced field %s is promoted through an embedded pointer", name)
+	}
+	return "s." + name, field.Type(), nil
+}
+
+// genCrossField 生成 eqfield、gtfield 等与同级字段比较的规则
+func (f *file) genCrossField(v value, rule vgenparser.Rule) (string, error) {
+	spec := crossFieldOps[rule.Name]
+	otherExpr, otherType, err := f.lookupField(rule.Value)
+	if err != nil {
+		return "", fmt.Errorf("rule '%s': %w", rule.Name, err)
+	}
+	if otherExpr == strings.TrimLeft(v.expr, "*") {
+		return "", fmt.Errorf("rule '%s' cannot reference the field itself", rule.Name)
+	}
+
+	incompatible := fmt.Errorf("rule '%s': field %s of type %s cannot be compared with type %s",
+		rule.Name, rule.Value, f.typeString(otherType), f.typeString(v.typ))
+	msg := spec.desc + " " + rule.Value
+
+	// time.Time 使用 Equal/Before/After 比较，忽略单调时钟和时区的差异
+	if isNamed(v.typ, "time", "Time") {
+		if !isNamed(otherType, "time", "Time") {
+			return "", incompatible
+		}
+		return f.check(fmt.Sprintf(spec.timeOp, v.recv(), otherExpr), f.fail(v, rule, msg)), nil
+	}
+
+	k := kindOf(v.typ)
+	switch {
+	case spec.ordered && k != kindString && !k.isNumeric():
+		return "", f.notApplicable(rule, v)
+	case !spec.ordered && (!types.Comparable(v.typ) || k == kindPointer || k == kindInterface):
+		return "", f.notApplicable(rule, v)
+	}
+
+	// 类型不完全相同但底层是同一种基本类型时（例如 type SKU string 与 string），把对方转换为本字段的类型
+	if !types.Identical(v.typ, otherType) {
+		vb, ok1 := v.typ.Underlying().(*types.Basic)
+		ob, ok2 := otherType.Underlying().(*types.Basic)
+		if !ok1 || !ok2 || vb.Kind() != ob.Kind() {
+			return "", incompatible
+		}
+		otherExpr = fmt.Sprintf("%s(%s)", f.typeExpr(v.typ), otherExpr)
+	}
+
+	return f.check(fmt.Sprintf("%s %s %s", v.expr, spec.op, otherExpr), f.fail(v, rule, msg)), nil
+}
+
+// genRequiredIf 生成条件必填规则：
+//   - required_if=Type:company|Country:CN 在所有条件都成立时要求字段非零值；
+//   - required_unless=Type:person 在条件不全成立时要求字段非零值；
+//   - required_with=Email|Phone 在任一字段非零值时要求字段非零值；
+//   - required_without=Email|Phone 在任一字段为零值时要求字段非零值。
+func (f *file) genRequiredIf(v value, rule vgenparser.Rule) (string, error) {
+	if len(rule.Values) == 0 {
+		return "", fmt.Errorf("rule '%s' requires at least one field", rule.Name)
+	}
+	zero, err := f.zeroCheck(v)
+	if err != nil {
+		return "", err
+	}
+
+	var conds, descs []string
+	for _, item := range rule.Values {
+		name, want, hasValue := strings.Cut(item, ":")
+		conditional := rule.Name == "required_if" || rule.Name == "required_unless"
+		if conditional != hasValue {
+			if conditional {
+				return "", fmt.Errorf("rule '%s': condition %q must have the form Field:value", rule.Name, item)
+			}
+			return "", fmt.Errorf("rule '%s': %q must be a field name", rule.Name, item)
+		}
+
+		expr, typ, err := f.lookupField(name)
+		if err != nil {
+			return "", fmt.Errorf("rule '%s': %w", rule.Name, err)
+		}
+		if expr == strings.TrimLeft(v.expr, "*") {
+			return "", fmt.Errorf("rule '%s' cannot reference the field itself", rule.Name)
+		}
+
+		if conditional {
+			// 指针字段的条件要求指针非 nil 且指向的值等于给定值
+			nilCheck := ""
+			if ptr, ok := typ.Underlying().(*types.Pointer); ok {
+				nilCheck, expr, typ = expr+" != nil && ", "*"+expr, ptr.Elem()
+			}
+			lit, err := f.literal(typ, want)
+			if err != nil {
+				return "", fmt.Errorf("rule '%s': field %s: %w", rule.Name, name, err)
+			}
+			conds = append(conds, fmt.Sprintf("%s%s == %s", nilCheck, expr, lit))
+			descs = append(descs, name+" is "+want)
+			continue
+		}
+
+		otherZero, err := f.zeroCheck(value{expr: expr, typ: typ})
+		if err != nil {
+			return "", fmt.Errorf("rule '%s': field %s: %w", rule.Name, name, err)
+		}
+		if rule.Name == "required_with" {
+			otherZero = negate(otherZero)
+		}
+		conds = append(conds, otherZero)
+		descs = append(descs, name)
+	}
+
+	var cond, msg string
+	switch rule.Name {
+	case "required_if":
+		cond, msg = strings.Join(conds, " && "), "is required when "+strings.Join(descs, " and ")
+	case "required_unless":
+		cond, msg = negate(strings.Join(conds, " && ")), "is required unless "+strings.Join(descs, " and ")
+	case "required_with":
+		cond, msg = strings.Join(conds, " || "), "is required when "+strings.Join(descs, " or ")+" is present"
+	case "required_without":
+		cond, msg = strings.Join(conds, " || "), "is required when "+strings.Join(descs, " or ")+" is absent"
+	}
+	if len(conds) > 1 && strings.Contains(cond, "||") {
+		cond = "(" + cond + ")"
+	}
+	return f.check(cond+" && "+zero, f.fail(v, rule, msg)), nil
+}
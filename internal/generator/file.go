@@ -0,0 +1,205 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"go/types"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+// file 记录生成单个 _validator.go 文件所需的状态：所在包的共享状态和该文件用到的导入
+type file struct {
+	p       *pkgState
+	pkg     *types.Package
+	info    *types.Info
+	imports map[string]bool
+	cur     *types.Struct // 正在生成 Validate() 的结构体，跨字段规则在其中查找同级字段
+}
+
+func newFile(p *pkgState) *file {
+	return &file{
+		p:       p,
+		pkg:     p.pkg.Types,
+		info:    p.pkg.TypesInfo,
+		imports: make(map[string]bool),
+	}
+}
+
+// use 记录生成代码需要导入的包
+func (f *file) use(path string) {
+	f.imports[path] = true
+}
+
+// typeExpr 返回类型在生成代码中的写法，并记录其引用的其它包
+func (f *file) typeExpr(t types.Type) string {
+	return types.TypeString(t, func(p *types.Package) string {
+		if p == f.pkg {
+			return ""
+		}
+		f.use(p.Path())
+		return p.Name()
+	})
+}
+
+// typeString 返回用于错误信息的类型名称
+func (f *file) typeString(t types.Type) string {
+	return types.TypeString(t, types.RelativeTo(f.pkg))
+}
+
+// useHelper 记录生成代码调用的共享辅助函数
+func (f *file) useHelper(name string) {
+	if _, ok := helpers[name]; !ok {
+		panic("generator: unknown helper " + name)
+	}
+	f.p.helpers[name] = true
+}
+
+// usePattern 返回预编译正则表达式 expr 的包级变量名；相同的表达式在包内只编译一次
+func (f *file) usePattern(expr string) string {
+	i := slices.Index(f.p.patterns, expr)
+	if i < 0 {
+		i = len(f.p.patterns)
+		f.p.patterns = append(f.p.patterns, expr)
+	}
+	return fmt.Sprintf("vgenPattern%d", i)
+}
+
+// importsTemplate 输出 import 块，标准库与其它包之间用空行分隔
+var importsTemplate = template.Must(template.New("imports").Parse(`
+{{- if .}}
+import (
+{{- range .}}
+{{- if eq . ""}}
+{{else}}
+	"{{.}}"
+{{- end}}
+{{- end}}
+)
+{{end}}`))
+
+// generatedHeader 是 vgen 生成的每个文件的第一行，只有带这一行的文件才会被覆盖或删除
+const generatedHeader = "// Code generated by VGen. DO NOT EDIT."
+
+var fileTemplate = template.Must(template.Must(importsTemplate.Clone()).New("file").Parse(generatedHeader + `
+
+package {{.Package}}
+{{template "imports" .Imports}}
+{{- range .Structs}}
+// Validate checks the fields of {{.Name}} and returns all validation errors.
+func (s *{{.Name}}) Validate() error {
+	var errs verr.ValidationErrors
+{{range .Fields}}{{range .Validators}}
+	{{.}}
+{{- end}}{{end}}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+{{end}}`))
+
+var helpersTemplate = template.Must(template.Must(importsTemplate.Clone()).New("helpers").Parse(generatedHeader + `
+
+package {{.Package}}
+{{template "imports" .Imports}}
+{{- range .Helpers}}
+{{.}}
+{{end}}`))
+
+// render 把收集到的结构体渲染成完整的 Go 源文件，并用 go/format 格式化
+func (f *file) render(structs []StructInfo) ([]byte, error) {
+	f.use(verrPath)
+
+	return execute(fileTemplate, struct {
+		Package string
+		Imports []string
+		Structs []StructInfo
+	}{
+		Package: f.pkg.Name(),
+		Imports: groupImports(f.imports),
+		Structs: structs,
+	})
+}
+
+// renderHelpers 渲染包内共享的辅助函数和预编译正则表达式文件 vgen_helpers.go
+func renderHelpers(p *pkgState) ([]byte, error) {
+	imports := make(map[string]bool)
+	var code []string
+	if len(p.patterns) > 0 {
+		imports["regexp"] = true
+		var b strings.Builder
+		b.WriteString("// Precompiled regular expressions used by pattern rules.\nvar (\n")
+		for i, expr := range p.patterns {
+			lit := "`" + expr + "`"
+			if strings.Contains(expr, "`") {
+				lit = strconv.Quote(expr)
+			}
+			fmt.Fprintf(&b, "vgenPattern%d = regexp.MustCompile(%s)\n", i, lit)
+		}
+		b.WriteString(")")
+		code = append(code, b.String())
+	}
+	for _, name := range sortedKeys(p.helpers) {
+		h := helpers[name]
+		code = append(code, h.code)
+		for _, path := range h.imports {
+			imports[path] = true
+		}
+	}
+
+	return execute(helpersTemplate, struct {
+		Package string
+		Imports []string
+		Helpers []string
+	}{
+		Package: p.pkg.Name,
+		Imports: groupImports(imports),
+		Helpers: code,
+	})
+}
+
+// execute 执行模板并用 go/format 格式化结果
+func execute(tmpl *template.Template, data any) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return nil, fmt.Errorf("failed to execute template: %w", err)
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to format generated code: %w\n%s", err, buf.Bytes())
+	}
+	return src, nil
+}
+
+// groupImports 返回排好序的导入路径，标准库在前，其它包在后，两组之间用空字符串分隔
+func groupImports(imports map[string]bool) []string {
+	var std, other []string
+	for _, path := range sortedKeys(imports) {
+		if strings.Contains(strings.SplitN(path, "/", 2)[0], ".") {
+			other = append(other, path)
+		} else {
+			std = append(std, path)
+		}
+	}
+	if len(std) > 0 && len(other) > 0 {
+		std = append(std, "")
+	}
+	return append(std, other...)
+}
+
+// sortedKeys 返回按字典序排列的 map 键，保证生成结果稳定
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -1,4 +1,3 @@
-// internal/generator/generate.go
 package generator
 
 import (
@@ -7,9 +6,10 @@ import (
 	"go/parser"
 	"go/token"
 	"os"
-	"reflect"
+	"path/filepath"
 	"strings"
-	"text/template"
+
+	"golang.org/x/tools/go/packages"
 
 	vgenparser "github.com/hiramkuang/vgen/internal/parser"
 )
@@ -27,230 +27,217 @@ type StructInfo struct {
 	Fields []FieldInfo
 }
 
-// GenerateValidator 为指定的 Go 文件生成 Validate() 方法
+// Options 控制代码生成行为
+type Options struct {
+	Dir       string // 解析相对路径和包模式时的工作目录，默认为当前目录
+	Recursive bool   // 目录参数是否递归处理子目录
+	Verbose   bool   // 打印调试信息
+	Runes     bool   // 字符串的 min、max 和 len 按 Unicode 字符（rune）而不是字节计数
+}
+
+// generator 保存一次生成过程的配置
+type generator struct {
+	opts Options
+}
+
+// GenerateValidator 为指定的 Go 文件生成 <file>_validator.go，
+// 其中包含该文件中所有带 vgen 标签的结构体的 Validate() 方法
 func GenerateValidator(filePath string) error {
-	fmt.Printf("Debug: Parsing file %s\n", filePath)
-	fset := token.NewFileSet()
-	node, err := parser.ParseFile(fset, filePath, nil, parser.ParseComments)
+	_, err := Generate(Options{}, filePath)
+	return err
+}
+
+// Generate 加载 args 指定的文件、目录或包模式（例如 ./...），
+// 为其中每个含有 vgen 标签的源文件生成 <file>_validator.go，
+// 并把包内共享的辅助函数写入 vgen_helpers.go。返回所有写入的文件路径。
+//
+// _test.go、vendor 目录下的文件以及已生成的文件会被跳过。
+// 指定单个文件时只重新生成该文件的校验代码，但仍会分析整个包以确定需要的辅助函数。
+func Generate(opts Options, args ...string) ([]string, error) {
+	g := &generator{opts: opts}
+
+	t, err := resolveArgs(opts.Dir, args, opts.Recursive)
 	if err != nil {
-		return fmt.Errorf("failed to parse file: %w", err)
+		return nil, err
+	}
+	pkgs, err := g.load(t)
+	if err != nil {
+		return nil, err
 	}
-	fmt.Printf("Debug: Parsed package %s\n", node.Name.Name)
-
-	// 收集所有结构体信息
-	var structInfos []StructInfo
 
-	// 遍历文件中的所有声明
-	for _, decl := range node.Decls {
-		genDecl, ok := decl.(*ast.GenDecl)
-		if !ok || genDecl.Tok != token.TYPE {
-			continue
+	// 先扫描所有包，使跨包嵌套的结构体在一次运行中就能确定是否需要校验
+	all := make(map[string]*pkgState)
+	var states []*pkgState
+	for _, pkg := range pkgs {
+		g.debugf("Parsing package %s", pkg.PkgPath)
+		p := newPkgState(pkg, opts.Runes)
+		p.files, p.paths = sourceFiles(pkg)
+		if err := p.scan(); err != nil {
+			return nil, fmt.Errorf("package %s: %w", pkg.PkgPath, err)
 		}
-
-		// 遍历类型声明中的所有规格 (Spec)
-		for _, spec := range genDecl.Specs {
-			typeSpec, ok := spec.(*ast.TypeSpec)
-			if !ok {
-				continue
-			}
-
-			// 检查是否为结构体类型
-			structType, ok := typeSpec.Type.(*ast.StructType)
-			if !ok {
-				continue
+		p.all = all
+		all[pkg.PkgPath] = p
+		states = append(states, p)
+	}
+	for changed := true; changed; {
+		changed = false
+		for _, p := range states {
+			if p.propagate() {
+				changed = true
 			}
+		}
+	}
 
-			// 创建结构体信息
-			structInfo := StructInfo{Name: typeSpec.Name.Name}
+	var written []string
+	for _, p := range states {
+		files, err := g.generatePackage(p, explicitFiles(p.paths, t.files))
+		if err != nil {
+			return written, err
+		}
+		written = append(written, files...)
+	}
+	return written, nil
+}
 
-			// 遍历结构体的字段
-			for _, field := range structType.Fields.List {
-				// 忽略没有名字的字段（如嵌入结构体）
-				if len(field.Names) == 0 {
-					continue
-				}
+// sourceFiles 返回包中参与生成的源文件及其路径，跳过 _test.go、vendor 目录下的文件和已生成的文件
+func sourceFiles(pkg *packages.Package) ([]*ast.File, []string) {
+	var files []*ast.File
+	var paths []string
+	for i, node := range pkg.Syntax {
+		path := pkg.CompiledGoFiles[i]
+		if ast.IsGenerated(node) || isVendored(path) || strings.HasSuffix(path, "_test.go") {
+			continue
+		}
+		files = append(files, node)
+		paths = append(paths, path)
+	}
+	return files, paths
+}
 
-				// 获取字段名
-				fieldName := field.Names[0].Name
+// explicitFiles 返回 paths 中被显式指定的文件。包中没有显式指定的文件时返回 nil，表示处理整个包，
+// 这样 "vgen a.go ./sub" 只重新生成 a.go，但仍会完整处理 sub 目录。
+func explicitFiles(paths []string, files map[string]bool) map[string]bool {
+	var only map[string]bool
+	for _, path := range paths {
+		if files[path] {
+			if only == nil {
+				only = make(map[string]bool)
+			}
+			only[path] = true
+		}
+	}
+	return only
+}
 
-				// 获取字段类型（用于生成更精确的校验代码）
-				var fieldType string
-				switch t := field.Type.(type) {
-				case *ast.Ident:
-					fieldType = t.Name
-				case *ast.SelectorExpr:
-					if ident, ok := t.X.(*ast.Ident); ok {
-						fieldType = ident.Name + "." + t.Sel.Name
-					}
-				default:
-					fieldType = "unsupported"
-				}
+// generatePackage 为单个包生成校验代码；only 不为空时只写入其中列出的文件
+func (g *generator) generatePackage(p *pkgState, only map[string]bool) ([]string, error) {
+	var written []string
+	for i, node := range p.files {
+		path := p.paths[i]
+		g.debugf("Parsing file %s", path)
 
-				// 获取 vgen tag
-				var tagValue string
-				if field.Tag != nil {
-					tagStr := strings.Trim(field.Tag.Value, "`")
-					tagValue = reflect.StructTag(tagStr).Get("vgen")
-				}
+		f := newFile(p)
+		structInfos, err := f.collectStructs(node)
+		if err != nil {
+			return written, err
+		}
+		// 指定了具体文件时，其它文件只参与辅助函数的统计
+		if len(only) > 0 && !only[path] {
+			continue
+		}
 
-				// 如果没有 vgen tag，则跳过
-				if tagValue == "" {
-					continue
-				}
+		outPath := strings.TrimSuffix(path, ".go") + "_validator.go"
+		// 文件中没有任何需要校验的结构体，不生成空文件，并清理之前生成的结果
+		if len(structInfos) == 0 {
+			if err := removeGenerated(outPath); err != nil {
+				return written, err
+			}
+			continue
+		}
 
-				// 使用我们的 parser 解析 tag
-				rules, err := vgenparser.ParseTag(tagValue)
-				if err != nil {
-					return fmt.Errorf("error parsing tag for field %s.%s: %w", structInfo.Name, fieldName, err)
-				}
+		src, err := f.render(structInfos)
+		if err != nil {
+			return written, err
+		}
+		if err := writeGenerated(outPath, src); err != nil {
+			return written, err
+		}
+		written = append(written, outPath)
+	}
 
-				// --- 核心：为每个规则生成校验代码片段 (优化后) ---
-				var validators []string
-				for _, rule := range rules {
-					var code string
-					switch rule.Name {
-					case "required":
-						switch fieldType {
-						case "string":
-							code = fmt.Sprintf("if s.%s == \"\" { errs = append(errs, fmt.Errorf(\"field %%s is required\", \"%s\")) }", fieldName, fieldName)
-						case "int", "int8", "int16", "int32", "int64", "uint", "uint8", "uint16", "uint32", "uint64":
-							code = fmt.Sprintf("if s.%s == 0 { errs = append(errs, fmt.Errorf(\"field %%s is required\", \"%s\")) }", fieldName, fieldName)
-						default:
-							code = fmt.Sprintf("// TODO: Implement 'required' check for type %s", fieldType)
-						}
-					case "min":
-						switch fieldType {
-						case "string":
-							if v, err := rule.GetIntValue(); err == nil {
-								code = fmt.Sprintf("if len(s.%s) < %d { errs = append(errs, fmt.Errorf(\"field %%s length must be at least %%d, got %%d\", \"%s\", %d, len(s.%s))) }", fieldName, v, fieldName, v, fieldName)
-							} else {
-								return fmt.Errorf("invalid 'min' value for string field %s.%s: %w", structInfo.Name, fieldName, err)
-							}
-						case "int":
-							if v, err := rule.GetIntValue(); err == nil {
-								code = fmt.Sprintf("if s.%s < %d { errs = append(errs, fmt.Errorf(\"field %%s must be at least %%d, got %%d\", \"%s\", %d, s.%s)) }", fieldName, v, fieldName, v, fieldName)
-							} else {
-								return fmt.Errorf("invalid 'min' value for int field %s.%s: %w", structInfo.Name, fieldName, err)
-							}
-						default:
-							code = fmt.Sprintf("// TODO: Implement 'min' check for type %s", fieldType)
-						}
-					case "max":
-						switch fieldType {
-						case "string":
-							if v, err := rule.GetIntValue(); err == nil {
-								code = fmt.Sprintf("if len(s.%s) > %d { errs = append(errs, fmt.Errorf(\"field %%s length must be at most %%d, got %%d\", \"%s\", %d, len(s.%s))) }", fieldName, v, fieldName, v, fieldName)
-							} else {
-								return fmt.Errorf("invalid 'max' value for string field %s.%s: %w", structInfo.Name, fieldName, err)
-							}
-						case "int":
-							if v, err := rule.GetIntValue(); err == nil {
-								code = fmt.Sprintf("if s.%s > %d { errs = append(errs, fmt.Errorf(\"field %%s must be at most %%d, got %%d\", \"%s\", %d, s.%s)) }", fieldName, v, fieldName, v, fieldName)
-							} else {
-								return fmt.Errorf("invalid 'max' value for int field %s.%s: %w", structInfo.Name, fieldName, err)
-							}
-						default:
-							code = fmt.Sprintf("// TODO: Implement 'max' check for type %s", fieldType)
-						}
-					case "email":
-						if fieldType == "string" {
-							code = fmt.Sprintf("if !isEmailValid(s.%s) { errs = append(errs, fmt.Errorf(\"field %%s is not a valid email\", \"%s\")) }", fieldName, fieldName)
-						} else {
-							return fmt.Errorf("rule 'email' is not applicable to field %s.%s of type %s", structInfo.Name, fieldName, fieldType)
-						}
-					// --- 新增规则开始 ---
-					case "len":
-						// len 规则适用于 string 和 slice
-						if strings.HasPrefix(fieldType, "[]") || fieldType == "string" {
-							if v, err := rule.GetIntValue(); err == nil {
-								// 对于 string 和 slice，都使用 len() 函数
-								code = fmt.Sprintf("if len(s.%s) != %d { errs = append(errs, fmt.Errorf(\"field %%s length must be %%d, got %%d\", \"%s\", %d, len(s.%s))) }", fieldName, v, fieldName, v, fieldName)
-							} else {
-								return fmt.Errorf("invalid 'len' value for field %s.%s: %w", structInfo.Name, fieldName, err)
-							}
-						} else {
-							return fmt.Errorf("rule 'len' is not applicable to field %s.%s of type %s", structInfo.Name, fieldName, fieldType)
-						}
-					case "in":
-						// in 规则目前主要适用于 string (可以扩展)
-						if fieldType == "string" {
-							// 获取值列表
-							inValues := rule.GetInValues()
-							if len(inValues) > 0 {
-								// 生成一个 map 来进行 O(1) 查找，提高效率
-								// map[值]bool
-								allowedMap := make(map[string]bool, len(inValues))
-								for _, val := range inValues {
-									allowedMap[val] = true
-								}
-								// 将 map 转换为 Go 代码中的 map 字面量
-								mapLiteral := "map[string]bool{"
-								for _, val := range inValues { // 使用 range inValues 保留所有值和顺序
-									// 对键进行转义，以防包含引号等特殊字符
-									mapLiteral += fmt.Sprintf("%q: true,", val)
-								}
-								mapLiteral += "}"
+	helpersPath := filepath.Join(packageDir(p.pkg), "vgen_helpers.go")
+	if len(p.helpers) == 0 && len(p.patterns) == 0 {
+		return written, removeGenerated(helpersPath)
+	}
+	src, err := renderHelpers(p)
+	if err != nil {
+		return written, err
+	}
+	if err := writeGenerated(helpersPath, src); err != nil {
+		return written, err
+	}
+	return append(written, helpersPath), nil
+}
 
-								// 生成校验代码
-								// 注意：我们在生成的代码中定义 map，以避免在包级别定义过多全局变量
-								code = fmt.Sprintf(`
-{
-	allowedValues := %s
-	if !allowedValues[s.%s] {
-		errs = append(errs, fmt.Errorf("field %%s value '%%s' is not in the allowed list [%%s]", "%s", s.%s, %q))
+// debugf 在 verbose 模式下打印调试信息
+func (g *generator) debugf(format string, args ...any) {
+	if g.opts.Verbose {
+		fmt.Fprintf(os.Stderr, "Debug: "+format+"\n", args...)
 	}
-}`, mapLiteral, fieldName, fieldName, fieldName, strings.Join(inValues, ", "))
-							} else {
-								return fmt.Errorf("invalid 'in' value for field %s.%s: %w", structInfo.Name, fieldName, err)
-							}
-						} else {
-							return fmt.Errorf("rule 'in' is not applicable to field %s.%s of type %s", structInfo.Name, fieldName, fieldType)
-						}
-					// --- 新增规则结束 ---
-					default:
-						return fmt.Errorf("unknown rule %s for field %s.%s", rule.Name, structInfo.Name, fieldName)
-					}
-					validators = append(validators, code)
-				}
+}
 
-				// 保存字段信息
-				structInfo.Fields = append(structInfo.Fields, FieldInfo{
-					Name:       fieldName,
-					Rules:      rules,
-					Validators: validators,
-				})
-			}
+// packageDir 返回包所在的目录
+func packageDir(pkg *packages.Package) string {
+	if pkg.Dir != "" {
+		return pkg.Dir
+	}
+	return filepath.Dir(pkg.CompiledGoFiles[0])
+}
 
-			// 保存结构体信息
-			structInfos = append(structInfos, structInfo)
-		}
+// writeGenerated 写入生成的文件；若目标文件存在且不是生成的文件，则拒绝覆盖
+func writeGenerated(path string, src []byte) error {
+	if generated, err := isGeneratedFile(path); err != nil {
+		return err
+	} else if !generated {
+		return fmt.Errorf("refusing to overwrite %s: file exists and was not generated by vgen", path)
 	}
+	if err := os.WriteFile(path, src, 0644); err != nil {
+		return fmt.Errorf("failed to write generated code to file: %w", err)
+	}
+	return nil
+}
 
-	// 为每个结构体生成 Validate() 方法
-	for _, structInfo := range structInfos {
-		// 生成 Validate() 方法的代码
-		var validateMethod strings.Builder
-		validateMethod.WriteString(fmt.Sprintf("func (s *%s) Validate() error {\n", structInfo.Name))
-		validateMethod.WriteString("var errs []error\n")
+// removeGenerated 删除之前生成、现在已不再需要的文件
+func removeGenerated(path string) error {
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return nil
+	}
+	generated, err := isGeneratedFile(path)
+	if err != nil || !generated {
+		return err
+	}
+	return os.Remove(path)
+}
 
-		// 为每个字段生成校验代码
-		for _, fieldInfo := range structInfo.Fields {
-			for _, validator := range fieldInfo.Validators {
-				validateMethod.WriteString(validator + "\n")
-			}
+// isGeneratedFile 判断 path 是否不存在或由 vgen 生成。
+// 其它工具生成的文件（同样带有 "Code generated ... DO NOT EDIT." 标记）不算在内。
+func isGeneratedFile(path string) (bool, error) {
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return true, nil
+	}
+	node, err := parser.ParseFile(token.NewFileSet(), path, nil, parser.PackageClauseOnly|parser.ParseComments)
+	if err != nil {
+		return false, fmt.Errorf("failed to parse existing file %s: %w", path, err)
+	}
+	for _, group := range node.Comments {
+		if group.Pos() > node.Package {
+			break
 		}
-
-		validateMethod.WriteString("if len(errs) > 0 {\n")
-		validateMethod.WriteString("return fmt.Errorf(strings.Join(errs, \"\\n\"))\n")
-		validateMethod.WriteString("}\n")
-		validateMethod.WriteString("return nil\n")
-
-		// 将生成的代码写入文件
-		err := os.WriteFile(structInfo.Name+"_gen.go", []byte(validateMethod.String()), 0644)
-		if err != nil {
-			return fmt.Errorf("failed to write generated code to file: %w", err)
+		for _, c := range group.List {
+			if c.Text == generatedHeader {
+				return true, nil
+			}
 		}
 	}
-
-	return nil
+	return false, nil
 }
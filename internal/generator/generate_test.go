@@ -0,0 +1,768 @@
+package generator
+
+import (
+	"go/format"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"testing"
+)
+
+// generateSource 在临时模块中写入 demo.go 并对其运行生成器，返回生成的 demo_validator.go 内容
+func generateSource(t *testing.T, src string) (string, error) {
+	t.Helper()
+	return generateWith(t, Options{}, src)
+}
+
+// generateWith 与 generateSource 相同，但使用指定的生成选项
+func generateWith(t *testing.T, opts Options, src string) (string, error) {
+	t.Helper()
+	dir := writeModule(t, map[string]string{"demo.go": src})
+	opts.Dir = dir
+	if _, err := Generate(opts, "."); err != nil {
+		return "", err
+	}
+	out, err := os.ReadFile(filepath.Join(dir, "demo_validator.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(out), nil
+}
+
+// writeModule 在临时目录中创建模块 example.com/demo 并写入给定的文件，返回模块目录。
+// go.mod 把 vgen 替换为本仓库，生成的代码可以导入 verr 等运行时包并直接编译。
+func writeModule(t *testing.T, files map[string]string) string {
+	t.Helper()
+	root, err := filepath.Abs(filepath.Join("..", ".."))
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir := t.TempDir()
+	gomod := "module example.com/demo\n\ngo 1.25\n\nrequire github.com/hiramkuang/vgen v0.0.0\n\nreplace github.com/hiramkuang/vgen => " + root + "\n"
+	files["go.mod"] = gomod
+	for name, src := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(src), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+// vetModule 对模块运行 go vet，确认生成的代码可以编译
+func vetModule(t *testing.T, dir string) {
+	t.Helper()
+	cmd := exec.Command("go", "vet", "./...")
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "GOFLAGS=-mod=mod", "GOPROXY=off")
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("go vet failed: %v\n%s", err, out)
+	}
+}
+
+func TestGenerateErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want string
+	}{
+		{
+			name: "CrossFieldMissing",
+			src:  "type T struct {\n\tA int `vgen:\"eqfield=B\"`\n}",
+			want: "referenced field B does not exist",
+		},
+		{
+			name: "CrossFieldSelf",
+			src:  "type T struct {\n\tA int `vgen:\"nefield=A\"`\n}",
+			want: "cannot reference the field itself",
+		},
+		{
+			name: "CrossFieldIncompatible",
+			src:  "type T struct {\n\tA int `vgen:\"gtfield=B\"`\n\tB string\n}",
+			want: "cannot be compared with type",
+		},
+		{
+			name: "CrossFieldUnordered",
+			src:  "type T struct {\n\tA bool `vgen:\"ltfield=B\"`\n\tB bool\n}",
+			want: "not applicable to type bool",
+		},
+		{
+			name: "RequiredIfMissingValue",
+			src:  "type T struct {\n\tA int `vgen:\"required_if=B\"`\n\tB int\n}",
+			want: "must have the form Field:value",
+		},
+		{
+			name: "RequiredIfOverflow",
+			src:  "type T struct {\n\tA int `vgen:\"required_if=B:300\"`\n\tB uint8\n}",
+			want: "value '300' overflows type uint8",
+		},
+		{
+			name: "RequiredWithValue",
+			src:  "type T struct {\n\tA int `vgen:\"required_with=B:1\"`\n\tB int\n}",
+			want: "must be a field name",
+		},
+		{
+			name: "OmitEmptyRequired",
+			src:  "type T struct {\n\tA string `vgen:\"omitempty,required\"`\n}",
+			want: "conflicts with 'omitempty'",
+		},
+		{
+			name: "CompareOverflow",
+			src:  "type T struct {\n\tA int8 `vgen:\"lt=128\"`\n}",
+			want: "value '128' overflows type int8",
+		},
+		{
+			name: "CompareNegativeUnsigned",
+			src:  "type T struct {\n\tA uint `vgen:\"gte=-1\"`\n}",
+			want: "invalid value '-1' for type uint",
+		},
+		{
+			name: "CompareFloatOverflow",
+			src:  "type T struct {\n\tA float32 `vgen:\"lte=1e39\"`\n}",
+			want: "value '1e39' overflows type float32",
+		},
+		{
+			name: "MinOverflow",
+			src:  "type T struct {\n\tA uint8 `vgen:\"max=300\"`\n}",
+			want: "value '300' overflows type uint8",
+		},
+		{
+			name: "CompareNotNumeric",
+			src:  "type T struct {\n\tA string `vgen:\"gt=1\"`\n}",
+			want: "not applicable to type string",
+		},
+		{
+			name: "FloatInfinity",
+			src:  "type T struct {\n\tA float64 `vgen:\"lt=inf\"`\n}",
+			want: "invalid value 'inf' for type float64",
+		},
+		{
+			name: "FloatNaN",
+			src:  "type T struct {\n\tA float32 `vgen:\"eq=NaN\"`\n}",
+			want: "invalid value 'NaN' for type float32",
+		},
+		{
+			name: "PatternInvalid",
+			src:  "type T struct {\n\tA string `vgen:\"pattern=^[a-z\"`\n}",
+			want: "invalid 'pattern' value",
+		},
+		{
+			name: "PatternNotString",
+			src:  "type T struct {\n\tA int `vgen:\"pattern=^1$\"`\n}",
+			want: "not applicable to type int",
+		},
+		{
+			name: "RuneLenNotString",
+			src:  "type T struct {\n\tA []string `vgen:\"runemax=3\"`\n}",
+			want: "not applicable to type []string",
+		},
+		{
+			name: "LenNegative",
+			src:  "type T struct {\n\tA string `vgen:\"runemin=-1\"`\n}",
+			want: "length cannot be negative",
+		},
+		{
+			name: "SubstringEmpty",
+			src:  "type T struct {\n\tA string `vgen:\"contains=''\"`\n}",
+			want: "requires a non-empty value",
+		},
+		{
+			name: "SubstringNotString",
+			src:  "type T struct {\n\tA []byte `vgen:\"startswith=x\"`\n}",
+			want: "not applicable to type []byte",
+		},
+		{
+			name: "InOverflow",
+			src:  "type T struct {\n\tA uint8 `vgen:\"in=1|256\"`\n}",
+			want: "value '256' overflows type uint8",
+		},
+		{
+			name: "InDuplicate",
+			src:  "type T struct {\n\tA int `vgen:\"in=16|0x10\"`\n}",
+			want: "duplicate value '0x10'",
+		},
+		{
+			name: "InDuplicateConst",
+			src:  "type P int\n\nconst One P = 1\n\ntype T struct {\n\tA P `vgen:\"in=One|1\"`\n}",
+			want: "duplicate value '1'",
+		},
+		{
+			name: "InConstWrongType",
+			src:  "type P int\n\nconst One = 1\n\ntype T struct {\n\tA P `vgen:\"in=One\"`\n}",
+			want: "invalid value 'One' for type P",
+		},
+		{
+			name: "InNotApplicable",
+			src:  "type T struct {\n\tA bool `vgen:\"in=true\"`\n}",
+			want: "not applicable to type bool",
+		},
+		{
+			name: "EnumUnnamed",
+			src:  "type T struct {\n\tA string `vgen:\"enum\"`\n}",
+			want: "requires a named string or numeric type",
+		},
+		{
+			name: "EnumNoConstants",
+			src:  "type S string\n\ntype T struct {\n\tA S `vgen:\"enum\"`\n}",
+			want: "type S has no constants",
+		},
+		{
+			name: "EnumWithValue",
+			src:  "type S string\n\nconst X S = \"x\"\n\ntype T struct {\n\tA S `vgen:\"enum=X\"`\n}",
+			want: "takes no value",
+		},
+		{
+			name: "UniqueNotComparable",
+			src:  "type T struct {\n\tA [][]int `vgen:\"unique\"`\n}",
+			want: "elements of type []int are not comparable",
+		},
+		{
+			name: "UniqueMissingField",
+			src:  "type E struct{ X int }\n\ntype T struct {\n\tA []E `vgen:\"unique=Y\"`\n}",
+			want: "E has no field Y",
+		},
+		{
+			name: "UniqueFieldNotStruct",
+			src:  "type T struct {\n\tA []string `vgen:\"unique=Y\"`\n}",
+			want: "only applicable to slices and arrays of structs",
+		},
+		{
+			name: "UniqueNotCollection",
+			src:  "type T struct {\n\tA string `vgen:\"unique\"`\n}",
+			want: "not applicable to type string",
+		},
+		{
+			name: "FutureNotTime",
+			src:  "type T struct {\n\tA string `vgen:\"future\"`\n}",
+			want: "not applicable to type string",
+		},
+		{
+			name: "AfterBadTime",
+			src:  "import \"time\"\n\ntype T struct {\n\tA time.Time `vgen:\"after=yesterday\"`\n}",
+			want: "cannot parse 'yesterday' as a time",
+		},
+		{
+			name: "WithinBadDuration",
+			src:  "import \"time\"\n\ntype T struct {\n\tA time.Time `vgen:\"within=30d\"`\n}",
+			want: "must be a positive duration",
+		},
+		{
+			name: "DurationBadLiteral",
+			src:  "import \"time\"\n\ntype T struct {\n\tA time.Duration `vgen:\"max=1 hour\"`\n}",
+			want: "invalid value '1 hour' for type time.Duration",
+		},
+		{
+			name: "InDurationDuplicate",
+			src:  "import \"time\"\n\ntype T struct {\n\tA time.Duration `vgen:\"in=1h|3600s\"`\n}",
+			want: "duplicate value '3600s'",
+		},
+		{
+			name: "InDurationDuplicateNanoseconds",
+			src:  "import \"time\"\n\ntype T struct {\n\tA time.Duration `vgen:\"in=1s|1000000000\"`\n}",
+			want: "duplicate value '1000000000'",
+		},
+		{
+			name: "DatetimeNoElements",
+			src:  "type T struct {\n\tA string `vgen:\"datetime=yyyy-mm-dd\"`\n}",
+			want: "contains no date or time elements",
+		},
+		{
+			name: "DatetimeMissingLayout",
+			src:  "type T struct {\n\tA string `vgen:\"datetime\"`\n}",
+			want: "missing layout",
+		},
+		{
+			name: "DatetimeNotString",
+			src:  "import \"time\"\n\ntype T struct {\n\tA time.Time `vgen:\"datetime=2006-01-02\"`\n}",
+			want: "not applicable to type time.Time",
+		},
+		{
+			name: "CountryNotString",
+			src:  "type T struct {\n\tA []byte `vgen:\"iso3166\"`\n}",
+			want: "not applicable to type []byte",
+		},
+		{
+			name: "LuhnNotString",
+			src:  "type T struct {\n\tA int64 `vgen:\"luhn\"`\n}",
+			want: "not applicable to type int64",
+		},
+		{
+			name: "TaggedTypeWithValidate",
+			src:  "type T struct {\n\tA int `vgen:\"min=1\"`\n}\n\nfunc (T) Validate(strict bool) error { return nil }",
+			want: "type T has vgen tags but already declares a Validate method",
+		},
+		{
+			name: "UnknownRule",
+			src:  "type T struct {\n\tA int `vgen:\"bogus\"`\n}",
+			want: "unknown rule bogus",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := generateSource(t, "package demo\n\n"+tt.src+"\n")
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Generate() error = %v, want error containing %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestGeneratePatternShared(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"go.mod": "module example.com/demo\n\ngo 1.25\n",
+		"a.go":   "package demo\n\ntype A struct {\n\tX string `vgen:\"pattern=^a+$\"`\n\tY string `vgen:\"pattern=^b+$\"`\n}\n",
+		"b.go":   "package demo\n\ntype B struct {\n\tZ string `vgen:\"pattern=^a+$\"`\n}\n",
+	}
+	for name, src := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(src), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := Generate(Options{Dir: dir}, "."); err != nil {
+		t.Fatal(err)
+	}
+
+	helpers, err := os.ReadFile(filepath.Join(dir, "vgen_helpers.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := strings.Count(string(helpers), "regexp.MustCompile"); n != 2 {
+		t.Errorf("vgen_helpers.go compiles %d patterns, want 2:\n%s", n, helpers)
+	}
+	b, err := os.ReadFile(filepath.Join(dir, "b_validator.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(b), "vgenPattern0.MatchString(s.Z)") {
+		t.Errorf("b_validator.go does not reuse the pattern declared for a.go:\n%s", b)
+	}
+}
+
+func TestGeneratePatternAlternatives(t *testing.T) {
+	// 空的选择分支和分支两侧的空格是正则的一部分，不能被列表分隔符的规则丢掉
+	dir := writeModule(t, map[string]string{"demo.go": "package demo\n\ntype T struct {\n" +
+		"\tA string `vgen:\"pattern=^(a||b)$\"`\n" +
+		"\tB string `vgen:\"pattern=x|\"`\n" +
+		"\tC string `vgen:\"pattern=^a | b$\"`\n}\n"})
+	if _, err := Generate(Options{Dir: dir}, "."); err != nil {
+		t.Fatal(err)
+	}
+	helpers, err := os.ReadFile(filepath.Join(dir, "vgen_helpers.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"regexp.MustCompile(`^(a||b)$`)", "regexp.MustCompile(`x|`)", "regexp.MustCompile(`^a | b$`)"} {
+		if !strings.Contains(string(helpers), want) {
+			t.Errorf("vgen_helpers.go does not contain %q:\n%s", want, helpers)
+		}
+	}
+}
+
+func TestGenerateCrossField(t *testing.T) {
+	out, err := generateSource(t, `package demo
+
+type Code string
+
+type T struct {
+	A Code `+"`vgen:\"nefield=B\"`"+`
+	B string
+	C float64 `+"`vgen:\"ltefield=D\"`"+`
+	D float64
+}
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"s.A == Code(s.B)", "s.C > s.D"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("generated code does not contain %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestGenerateRunesOption(t *testing.T) {
+	src := "package demo\n\ntype T struct {\n\tA string `vgen:\"min=2,len=4\"`\n\tB []string `vgen:\"max=3\"`\n}\n"
+
+	out, err := generateSource(t, src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(out, "utf8.") {
+		t.Errorf("byte counting is the default, got:\n%s", out)
+	}
+
+	out, err = generateWith(t, Options{Runes: true}, src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"utf8.RuneCountInString(s.A) < 2", "utf8.RuneCountInString(s.A) != 4", "len(s.B) > 3"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("generated code does not contain %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestGenerateEnumImported(t *testing.T) {
+	out, err := generateSource(t, `package demo
+
+import "time"
+
+type T struct {
+	Month time.Month `+"`vgen:\"enum\"`"+`
+	Day   time.Weekday `+"`vgen:\"enum\"`"+`
+}
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		"case time.January, time.February, time.March,",
+		"case time.Sunday, time.Monday,",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("generated code does not contain %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestGenerateCompiles(t *testing.T) {
+	dir := writeModule(t, map[string]string{"demo.go": `package demo
+
+type Address struct {
+	City string ` + "`vgen:\"required,max=64\"`" + `
+}
+
+type User struct {
+	Name    string            ` + "`vgen:\"required,min=2,pattern=^[a-z]+$\"`" + `
+	Email   string            ` + "`vgen:\"omitempty,email\"`" + `
+	Age     *int              ` + "`vgen:\"gte=0,lte=150\"`" + `
+	Tags    []string          ` + "`vgen:\"unique,dive,min=1\"`" + `
+	Attrs   map[string]string ` + "`vgen:\"dive,keys,alpha,endkeys,max=8\"`" + `
+	Home    Address
+	Offices []*Address
+}
+`})
+	if _, err := Generate(Options{Dir: dir}, "."); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, name := range []string{"demo_validator.go", "vgen_helpers.go"} {
+		src, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		formatted, err := format.Source(src)
+		if err != nil {
+			t.Fatalf("%s is not valid Go: %v", name, err)
+		}
+		if string(formatted) != string(src) {
+			t.Errorf("%s is not gofmt-clean", name)
+		}
+	}
+	vetModule(t, dir)
+}
+
+func TestGenerateHelpersDoNotClash(t *testing.T) {
+	// 用户包中常见的函数名不能与 vgen_helpers.go 中的辅助函数冲突
+	dir := writeModule(t, map[string]string{"demo.go": `package demo
+
+func isNumeric(n int) bool     { return n >= 0 }
+func isURL(s string) bool      { return s != "" }
+func isEmailValid(string) bool { return true }
+func firstDuplicate() int      { return -1 }
+func isJSON() bool             { return true }
+func isSemver() bool           { return true }
+
+type T struct {
+	A string   ` + "`vgen:\"numeric\"`" + `
+	B string   ` + "`vgen:\"url\"`" + `
+	C string   ` + "`vgen:\"email\"`" + `
+	D []string ` + "`vgen:\"unique\"`" + `
+	E string   ` + "`vgen:\"json\"`" + `
+	F string   ` + "`vgen:\"semver\"`" + `
+}
+`})
+	if _, err := Generate(Options{Dir: dir}, "."); err != nil {
+		t.Fatal(err)
+	}
+	vetModule(t, dir)
+}
+
+func TestGenerateNestedAcrossPackages(t *testing.T) {
+	// a 嵌套 b 中带标签的结构体：b 尚未生成过 Validate()，一次运行也必须生成嵌套校验
+	dir := writeModule(t, map[string]string{
+		"a/a.go": "package a\n\nimport \"example.com/demo/b\"\n\ntype User struct {\n\tName string\n\tHome b.Address\n\tWork *b.Address\n}\n",
+		"b/b.go": "package b\n\ntype Address struct {\n\tCity string `vgen:\"required\"`\n}\n",
+	})
+	if _, err := Generate(Options{Dir: dir}, "./..."); err != nil {
+		t.Fatal(err)
+	}
+	out, err := os.ReadFile(filepath.Join(dir, "a", "a_validator.go"))
+	if err != nil {
+		t.Fatalf("a_validator.go was not generated on the first run: %v", err)
+	}
+	for _, want := range []string{`errs.Nest("Home", "Home", s.Home.Validate())`, `errs.Nest("Work", "Work", s.Work.Validate())`} {
+		if !strings.Contains(string(out), want) {
+			t.Errorf("a_validator.go does not contain %q:\n%s", want, out)
+		}
+	}
+	vetModule(t, dir)
+
+	// 再次运行结果不变
+	if _, err := Generate(Options{Dir: dir}, "./..."); err != nil {
+		t.Fatal(err)
+	}
+	again, err := os.ReadFile(filepath.Join(dir, "a", "a_validator.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(again) != string(out) {
+		t.Errorf("second run changed a_validator.go:\n%s\nfirst run:\n%s", again, out)
+	}
+}
+
+func TestGenerateIgnoresOtherValidateSignatures(t *testing.T) {
+	// 只有签名为 Validate() error 的方法才能用于嵌套校验，无论类型在本包还是其它包中
+	dir := writeModule(t, map[string]string{
+		"a/a.go": `package a
+
+import "example.com/demo/b"
+
+type Custom struct{ A string }
+
+func (c Custom) Validate(strict bool) error { return nil }
+
+type Checked struct{ A string }
+
+func (c *Checked) Validate() error { return nil }
+
+type User struct {
+	Name    string ` + "`vgen:\"required\"`" + `
+	Custom  Custom
+	Checked Checked
+	Remote  b.Remote
+}
+
+// Wrapper 只包含签名不符的 Validate，不需要生成
+type Wrapper struct {
+	Custom Custom
+	Remote *b.Remote
+}
+`,
+		"b/b.go": "package b\n\ntype Remote struct{ A string }\n\nfunc (r Remote) Validate(strict bool) error { return nil }\n",
+	})
+	if _, err := Generate(Options{Dir: dir}, "./..."); err != nil {
+		t.Fatal(err)
+	}
+	out, err := os.ReadFile(filepath.Join(dir, "a", "a_validator.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(out), `errs.Nest("Checked", "Checked", s.Checked.Validate())`) {
+		t.Errorf("a_validator.go does not nest Checked:\n%s", out)
+	}
+	for _, unwanted := range []string{"s.Custom.Validate", "s.Remote.Validate", "func (s *Wrapper)"} {
+		if strings.Contains(string(out), unwanted) {
+			t.Errorf("a_validator.go contains %q:\n%s", unwanted, out)
+		}
+	}
+	vetModule(t, dir)
+}
+
+// exists 判断文件是否存在
+func exists(t *testing.T, path string) bool {
+	t.Helper()
+	_, err := os.Stat(path)
+	if err != nil && !os.IsNotExist(err) {
+		t.Fatal(err)
+	}
+	return err == nil
+}
+
+func TestGenerateSkipsFiles(t *testing.T) {
+	tagged := "\n\ntype T struct {\n\tA string `vgen:\"required\"`\n}\n"
+	dir := writeModule(t, map[string]string{
+		"demo.go":      "package demo\n\ntype D struct {\n\tA string `vgen:\"required\"`\n}\n",
+		"demo_test.go": "package demo" + tagged,
+		"gen.go":       "// Code generated by stringer. DO NOT EDIT.\n\npackage demo" + strings.Replace(tagged, "T struct", "G struct", 1),
+	})
+	written, err := Generate(Options{Dir: dir}, ".")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(written) != 1 || filepath.Base(written[0]) != "demo_validator.go" {
+		t.Errorf("Generate() wrote %v, want only demo_validator.go", written)
+	}
+	for _, name := range []string{"demo_test_validator.go", "gen_validator.go"} {
+		if exists(t, filepath.Join(dir, name)) {
+			t.Errorf("%s was generated for a skipped file", name)
+		}
+	}
+
+	// 再次运行时，已生成的 demo_validator.go 不会被当作源文件
+	written, err = Generate(Options{Dir: dir}, ".")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(written) != 1 || exists(t, filepath.Join(dir, "demo_validator_validator.go")) {
+		t.Errorf("second run wrote %v, want only demo_validator.go", written)
+	}
+}
+
+func TestGenerateArguments(t *testing.T) {
+	files := map[string]string{
+		"a.go":     "package demo\n\ntype A struct {\n\tX string `vgen:\"email\"`\n}\n",
+		"b.go":     "package demo\n\ntype B struct {\n\tY string `vgen:\"required\"`\n}\n",
+		"sub/c.go": "package sub\n\ntype C struct {\n\tZ int `vgen:\"min=1\"`\n}\n",
+	}
+	tests := []struct {
+		name      string
+		args      []string
+		recursive bool
+		want      []string
+	}{
+		{"File", []string{"a.go"}, false, []string{"a_validator.go", "vgen_helpers.go"}},
+		{"OtherFile", []string{"b.go"}, false, []string{"b_validator.go", "vgen_helpers.go"}},
+		{"Dir", []string{"."}, false, []string{"a_validator.go", "b_validator.go", "vgen_helpers.go"}},
+		{"Subdir", []string{"sub"}, false, []string{"sub/c_validator.go"}},
+		{"Recursive", []string{"."}, true, []string{"a_validator.go", "b_validator.go", "sub/c_validator.go", "vgen_helpers.go"}},
+		{"Pattern", []string{"./..."}, false, []string{"a_validator.go", "b_validator.go", "sub/c_validator.go", "vgen_helpers.go"}},
+		{"FileAndDir", []string{"a.go", "sub"}, false, []string{"a_validator.go", "sub/c_validator.go", "vgen_helpers.go"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			src := make(map[string]string)
+			for name, s := range files {
+				src[name] = s
+			}
+			dir := writeModule(t, src)
+			written, err := Generate(Options{Dir: dir, Recursive: tt.recursive}, tt.args...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, path := range written {
+				rel, err := filepath.Rel(dir, path)
+				if err != nil {
+					t.Fatal(err)
+				}
+				got = append(got, filepath.ToSlash(rel))
+			}
+			sort.Strings(got)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Generate(%q) wrote %v, want %v", tt.args, got, tt.want)
+			}
+
+			// 磁盘上的生成文件与返回值一致，没有多余的文件
+			var onDisk []string
+			err = filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+				if err == nil && (strings.HasSuffix(path, "_validator.go") || d.Name() == "vgen_helpers.go") {
+					rel, _ := filepath.Rel(dir, path)
+					onDisk = append(onDisk, filepath.ToSlash(rel))
+				}
+				return err
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			sort.Strings(onDisk)
+			if !slices.Equal(onDisk, tt.want) {
+				t.Errorf("Generate(%q) left %v on disk, want %v", tt.args, onDisk, tt.want)
+			}
+		})
+	}
+}
+
+func TestGenerateRefusesHandWritten(t *testing.T) {
+	handWritten := "package demo\n\n// 手写的文件，不能被覆盖\nfunc helper() {}\n"
+	dir := writeModule(t, map[string]string{
+		"demo.go":           "package demo\n\ntype T struct {\n\tA string `vgen:\"email\"`\n}\n",
+		"demo_validator.go": handWritten,
+		"vgen_helpers.go":   handWritten,
+	})
+	_, err := Generate(Options{Dir: dir}, ".")
+	if err == nil || !strings.Contains(err.Error(), "refusing to overwrite") {
+		t.Fatalf("Generate() error = %v, want refusal to overwrite", err)
+	}
+	for _, name := range []string{"demo_validator.go", "vgen_helpers.go"} {
+		if got, err := os.ReadFile(filepath.Join(dir, name)); err != nil || string(got) != handWritten {
+			t.Errorf("%s was modified: %q, %v", name, got, err)
+		}
+	}
+}
+
+func TestGenerateRemovesStale(t *testing.T) {
+	handWritten := "package demo\n\nfunc helper() {}\n"
+	dir := writeModule(t, map[string]string{
+		"demo.go":          "package demo\n\ntype T struct {\n\tA string `vgen:\"email\"`\n}\n",
+		"old.go":           "package demo\n\ntype Old struct{ A string }\n",
+		"old_validator.go": handWritten,
+	})
+	if _, err := Generate(Options{Dir: dir}, "."); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"demo_validator.go", "vgen_helpers.go"} {
+		if !exists(t, filepath.Join(dir, name)) {
+			t.Fatalf("%s was not generated", name)
+		}
+	}
+
+	// 去掉标签后重新生成：之前生成的文件被删除，手写的同名文件保留
+	if err := os.WriteFile(filepath.Join(dir, "demo.go"), []byte("package demo\n\ntype T struct{ A string }\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Generate(Options{Dir: dir}, "."); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"demo_validator.go", "vgen_helpers.go"} {
+		if exists(t, filepath.Join(dir, name)) {
+			t.Errorf("stale %s was not removed", name)
+		}
+	}
+	if got, err := os.ReadFile(filepath.Join(dir, "old_validator.go")); err != nil || string(got) != handWritten {
+		t.Errorf("hand-written old_validator.go was modified: %q, %v", got, err)
+	}
+}
+
+func TestGenerateKeepsOtherGenerators(t *testing.T) {
+	// 其它工具生成的文件同样带有 DO NOT EDIT 标记，但不是 vgen 的输出，不能被覆盖或删除
+	mock := "// Code generated by mockgen. DO NOT EDIT.\n\npackage demo\n\nfunc mock() {}\n"
+	dir := writeModule(t, map[string]string{
+		"demo.go":           "package demo\n\ntype T struct {\n\tA string `vgen:\"email\"`\n}\n",
+		"demo_validator.go": mock,
+		"m.go":              "package demo\n\ntype M struct{ A string }\n",
+		"m_validator.go":    mock,
+	})
+	_, err := Generate(Options{Dir: dir}, ".")
+	if err == nil || !strings.Contains(err.Error(), "refusing to overwrite") {
+		t.Fatalf("Generate() error = %v, want refusal to overwrite", err)
+	}
+	for _, name := range []string{"demo_validator.go", "m_validator.go"} {
+		if got, err := os.ReadFile(filepath.Join(dir, name)); err != nil || string(got) != mock {
+			t.Errorf("%s was modified: %q, %v", name, got, err)
+		}
+	}
+
+	// 只剩下没有标签的 m.go 时，m_validator.go 也不会被当作过期的生成文件删除
+	if err := os.Remove(filepath.Join(dir, "demo.go")); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Remove(filepath.Join(dir, "demo_validator.go")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Generate(Options{Dir: dir}, "."); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := os.ReadFile(filepath.Join(dir, "m_validator.go")); err != nil || string(got) != mock {
+		t.Errorf("m_validator.go was modified: %q, %v", got, err)
+	}
+}
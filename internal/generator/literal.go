@@ -0,0 +1,80 @@
+package generator
+
+import (
+	"errors"
+	"fmt"
+	"go/types"
+	"math"
+	"strconv"
+	"time"
+)
+
+// literal 把标签中的值转换为可以与类型 t 的值直接比较的 Go 字面量。
+// 整数支持 0x、0o、0b 前缀和负数，超出类型范围的值在生成时报错；time.Duration 支持时长字面量。
+func (f *file) literal(t types.Type, s string) (string, error) {
+	// time.Duration 还可以写成 90s、1h30m 这样的时长
+	if isNamed(t, "time", "Duration") {
+		if d, err := time.ParseDuration(s); err == nil {
+			return f.durationExpr(d), nil
+		}
+	}
+
+	switch kindOf(t) {
+	case kindString:
+		return strconv.Quote(s), nil
+	case kindBool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return "", fmt.Errorf("invalid bool value '%s'", s)
+		}
+		return strconv.FormatBool(b), nil
+	case kindInt:
+		n, err := strconv.ParseInt(s, 0, bitSize(t))
+		if err != nil {
+			return "", f.numError(s, t, err)
+		}
+		return strconv.FormatInt(n, 10), nil
+	case kindUint:
+		n, err := strconv.ParseUint(s, 0, bitSize(t))
+		if err != nil {
+			return "", f.numError(s, t, err)
+		}
+		return strconv.FormatUint(n, 10), nil
+	case kindFloat:
+		n, err := strconv.ParseFloat(s, bitSize(t))
+		if err != nil {
+			return "", f.numError(s, t, err)
+		}
+		// Inf 和 NaN 没有对应的 Go 字面量
+		if math.IsInf(n, 0) || math.IsNaN(n) {
+			return "", fmt.Errorf("invalid value '%s' for type %s", s, f.typeString(t))
+		}
+		return strconv.FormatFloat(n, 'g', -1, bitSize(t)), nil
+	}
+	return "", fmt.Errorf("cannot compare type %s with a literal value", f.typeString(t))
+}
+
+// numError 把 strconv 的解析错误转换为面向标签作者的错误信息
+func (f *file) numError(s string, t types.Type, err error) error {
+	if errors.Is(err, strconv.ErrRange) {
+		return fmt.Errorf("value '%s' overflows type %s", s, f.typeString(t))
+	}
+	return fmt.Errorf("invalid value '%s' for type %s", s, f.typeString(t))
+}
+
+// bitSize 返回数值类型的位数；int、uint 和 uintptr 按 64 位处理
+func bitSize(t types.Type) int {
+	b, _ := t.Underlying().(*types.Basic)
+	if b == nil {
+		return 64
+	}
+	switch b.Kind() {
+	case types.Int8, types.Uint8:
+		return 8
+	case types.Int16, types.Uint16:
+		return 16
+	case types.Int32, types.Uint32, types.Float32:
+		return 32
+	}
+	return 64
+}
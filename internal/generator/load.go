@@ -0,0 +1,99 @@
+package generator
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// loadMode 是加载包时需要的信息：文件列表、语法树以及完整的类型信息
+const loadMode = packages.NeedName | packages.NeedFiles | packages.NeedCompiledGoFiles |
+	packages.NeedSyntax | packages.NeedTypes | packages.NeedTypesInfo
+
+// target 描述命令行参数解析后的加载请求
+type target struct {
+	patterns []string        // 传给 go/packages 的包模式
+	files    map[string]bool // 显式指定的源文件（绝对路径）；为空表示处理包内所有文件
+}
+
+// resolveArgs 把命令行参数（文件、目录或包模式）转换为 go/packages 可识别的模式。
+// 相对路径相对于 dir（为空时为当前目录）解析；recursive 为 true 时，目录参数按 dir/... 递归处理。
+func resolveArgs(dir string, args []string, recursive bool) (target, error) {
+	t := target{files: make(map[string]bool)}
+
+	for _, arg := range args {
+		path := arg
+		if dir != "" && !filepath.IsAbs(arg) {
+			path = filepath.Join(dir, arg)
+		}
+		info, err := os.Stat(path)
+		switch {
+		case err == nil && !info.IsDir():
+			if !strings.HasSuffix(arg, ".go") {
+				return target{}, fmt.Errorf("%s is not a Go source file", arg)
+			}
+			abs, err := filepath.Abs(path)
+			if err != nil {
+				return target{}, err
+			}
+			t.files[abs] = true
+			t.patterns = append(t.patterns, "file="+abs)
+		case err == nil && info.IsDir():
+			t.patterns = append(t.patterns, dirPattern(arg, recursive))
+		default:
+			// 既不是文件也不是目录，按包模式处理，例如 ./... 或完整导入路径
+			t.patterns = append(t.patterns, arg)
+		}
+	}
+
+	return t, nil
+}
+
+// load 加载目标包并检查加载、解析错误。
+// 类型错误会被容忍：首次生成之前，调用 Validate() 的代码本身就无法通过类型检查。
+func (g *generator) load(t target) ([]*packages.Package, error) {
+	cfg := &packages.Config{
+		Mode:  loadMode,
+		Dir:   g.opts.Dir,
+		Tests: false,
+	}
+	pkgs, err := packages.Load(cfg, t.patterns...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load packages: %w", err)
+	}
+	if len(pkgs) == 0 {
+		return nil, fmt.Errorf("no packages matched %s", strings.Join(t.patterns, " "))
+	}
+
+	for _, pkg := range pkgs {
+		for _, e := range pkg.Errors {
+			// 语法错误或者包根本无法加载时才失败；其余错误（类型错误、go list 的编译错误）
+			// 通常只是因为 Validate() 尚未生成或者已生成的代码过时
+			if e.Kind == packages.ParseError || pkg.Types == nil || len(pkg.Syntax) == 0 {
+				return nil, fmt.Errorf("failed to load package %s: %v", pkg.PkgPath, e)
+			}
+			g.debugf("ignoring error in %s: %v", pkg.PkgPath, e)
+		}
+	}
+	return pkgs, nil
+}
+
+// dirPattern 把目录路径转换为包模式。go list 只把以 . 开头或绝对路径的参数当作目录。
+func dirPattern(dir string, recursive bool) string {
+	p := filepath.ToSlash(filepath.Clean(dir))
+	if !filepath.IsAbs(dir) && p != "." && p != ".." && !strings.HasPrefix(p, "../") {
+		p = "./" + p
+	}
+	if recursive {
+		p += "/..."
+	}
+	return p
+}
+
+// isVendored 判断文件是否位于 vendor 目录中
+func isVendored(path string) bool {
+	return strings.Contains(filepath.ToSlash(path), "/vendor/")
+}
@@ -0,0 +1,87 @@
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestResolveArgs(t *testing.T) {
+	dir := writeModule(t, map[string]string{
+		"a.go":       "package demo\n",
+		"notes.txt":  "",
+		"sub/b.go":   "package sub\n",
+		"sub/c/c.go": "package c\n",
+	})
+	abs := filepath.Join(dir, "a.go")
+
+	tests := []struct {
+		name      string
+		args      []string
+		recursive bool
+		patterns  []string
+		files     map[string]bool
+	}{
+		{"File", []string{"a.go"}, false, []string{"file=" + abs}, map[string]bool{abs: true}},
+		{"AbsoluteFile", []string{abs}, false, []string{"file=" + abs}, map[string]bool{abs: true}},
+		{"Dir", []string{"sub"}, false, []string{"./sub"}, map[string]bool{}},
+		{"DirRecursive", []string{"sub"}, true, []string{"./sub/..."}, map[string]bool{}},
+		{"CurrentDir", []string{"."}, false, []string{"."}, map[string]bool{}},
+		{"CurrentDirRecursive", []string{"."}, true, []string{"./..."}, map[string]bool{}},
+		{"Pattern", []string{"./..."}, false, []string{"./..."}, map[string]bool{}},
+		{"ImportPath", []string{"example.com/demo/sub"}, true, []string{"example.com/demo/sub"}, map[string]bool{}},
+		{"Mixed", []string{"a.go", "sub/c"}, false, []string{"file=" + abs, "./sub/c"}, map[string]bool{abs: true}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := resolveArgs(dir, tt.args, tt.recursive)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got.patterns, tt.patterns) || !reflect.DeepEqual(got.files, tt.files) {
+				t.Errorf("resolveArgs(%q) = %v %v, want %v %v", tt.args, got.patterns, got.files, tt.patterns, tt.files)
+			}
+		})
+	}
+
+	if _, err := resolveArgs(dir, []string{"notes.txt"}, false); err == nil {
+		t.Error("resolveArgs accepted a file that is not Go source")
+	}
+}
+
+func TestDirPattern(t *testing.T) {
+	abs := filepath.Join(os.TempDir(), "pkg")
+	tests := []struct {
+		dir       string
+		recursive bool
+		want      string
+	}{
+		{".", false, "."},
+		{".", true, "./..."},
+		{"..", false, ".."},
+		{"../x", false, "../x"},
+		{"x/y/", false, "./x/y"},
+		{"./x", true, "./x/..."},
+		{abs, false, filepath.ToSlash(abs)},
+	}
+	for _, tt := range tests {
+		if got := dirPattern(tt.dir, tt.recursive); got != tt.want {
+			t.Errorf("dirPattern(%q, %v) = %q, want %q", tt.dir, tt.recursive, got, tt.want)
+		}
+	}
+}
+
+func TestIsVendored(t *testing.T) {
+	tests := map[string]bool{
+		"/src/app/vendor/example.com/x/x.go": true,
+		"/src/app/internal/x.go":             false,
+		"/src/app/vendors/x.go":              false,
+		"/src/app/myvendor/x.go":             false,
+	}
+	for path, want := range tests {
+		if got := isVendored(filepath.FromSlash(path)); got != want {
+			t.Errorf("isVendored(%q) = %v, want %v", path, got, want)
+		}
+	}
+}
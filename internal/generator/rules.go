@@ -0,0 +1,730 @@
+package generator
+
+import (
+	"fmt"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"path"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	vgenparser "github.com/hiramkuang/vgen/internal/parser"
+)
+
+// verrPath 是生成代码返回的结构化错误所在的包
+const verrPath = "github.com/hiramkuang/vgen/verr"
+
+// codesPath 是内置国家、货币和语言代码表所在的运行时包
+const codesPath = "github.com/hiramkuang/vgen/codes"
+
+// checksumPath 是 luhn、iban 等校验位规则所在的运行时包
+const checksumPath = "github.com/hiramkuang/vgen/checksum"
+
+// value 描述一个待校验的值：访问它的表达式、它的类型以及错误信息中使用的路径
+type value struct {
+	expr  string     // 生成代码中访问该值的表达式，例如 "s.Name"
+	typ   types.Type // 值的类型
+	name  string     // 字段名，对应 verr.FieldError.Field
+	path  string     // 生成字段路径的 Go 表达式，对应 verr.FieldError.Path，例如 `"Name"`
+	depth int        // dive 的嵌套层数，用于生成不冲突的循环变量名
+}
+
+// stringExpr 返回值的 string 表达式；命名字符串类型（type Email string）会被显式转换
+func (v value) stringExpr() string {
+	if types.Identical(v.typ, types.Typ[types.String]) {
+		return v.expr
+	}
+	return "string(" + v.expr + ")"
+}
+
+// recv 返回调用方法时使用的接收者表达式：选择器会自动解引用一层指针，因此 *s.X 写作 s.X，
+// 多层解引用则加上括号
+func (v value) recv() string {
+	switch {
+	case strings.HasPrefix(v.expr, "**"):
+		return "(" + v.expr + ")"
+	case strings.HasPrefix(v.expr, "*"):
+		return v.expr[1:]
+	}
+	return v.expr
+}
+
+// operand 返回可以直接索引或切片的表达式，解引用表达式 *s.X 需要加括号
+func (v value) operand() string {
+	if strings.HasPrefix(v.expr, "*") {
+		return "(" + v.expr + ")"
+	}
+	return v.expr
+}
+
+// genRules 为一个值生成所有规则的校验代码；dive 之后的规则作用于每个元素，
+// omitempty 之后的规则只在值不是零值时检查
+func (f *file) genRules(v value, rules []vgenparser.Rule) ([]string, error) {
+	if ptr, ok := v.typ.Underlying().(*types.Pointer); ok && len(rules) > 0 {
+		return f.genPointer(v, ptr.Elem(), rules)
+	}
+
+	var code []string
+	for i, rule := range rules {
+		if rule.Name == "omitempty" {
+			c, err := f.genOmitEmpty(v, rules[i+1:])
+			if err != nil {
+				return nil, err
+			}
+			return append(code, c...), nil
+		}
+		if rule.Name == "dive" {
+			c, err := f.genDive(v, rules[i+1:])
+			if err != nil {
+				return nil, err
+			}
+			return append(code, c), nil
+		}
+
+		c, err := f.genRule(v, rule)
+		if err != nil {
+			return nil, err
+		}
+		code = append(code, c)
+	}
+	return code, nil
+}
+
+// genPointer 生成指针值的规则：required 系列规则检查指针是否为 nil，omitempty 对指针而言是多余的
+// （nil 本来就会跳过），其余规则只在指针非 nil 时作用于解引用后的值。
+func (f *file) genPointer(v value, elem types.Type, rules []vgenparser.Rule) ([]string, error) {
+	var code []string
+	var elemRules []vgenparser.Rule
+	omitEmpty := false
+	for i, rule := range rules {
+		if rule.Name == "dive" {
+			elemRules = append(elemRules, rules[i:]...)
+			break
+		}
+		switch rule.Name {
+		case "omitempty":
+			omitEmpty = true
+		case "required", "required_if", "required_unless", "required_with", "required_without":
+			c, err := f.genRule(v, rule)
+			if err != nil {
+				return nil, err
+			}
+			code = append(code, c)
+		default:
+			elemRules = append(elemRules, rule)
+		}
+		if omitEmpty && rule.Name == "required" {
+			return nil, fmt.Errorf("rule 'required' conflicts with 'omitempty'")
+		}
+	}
+	if len(elemRules) == 0 {
+		return code, nil
+	}
+
+	deref := v
+	deref.expr, deref.typ = "*"+v.expr, elem
+	elemCode, err := f.genRules(deref, elemRules)
+	if err != nil || len(elemCode) == 0 {
+		return code, err
+	}
+	return append(code, f.check(v.expr+" != nil", strings.Join(elemCode, "\n"))), nil
+}
+
+// genOmitEmpty 把 rules 的校验代码包在“值非零”的判断中
+func (f *file) genOmitEmpty(v value, rules []vgenparser.Rule) ([]string, error) {
+	for _, rule := range rules {
+		if rule.Name == "dive" {
+			break
+		}
+		if rule.Name == "required" {
+			return nil, fmt.Errorf("rule 'required' conflicts with 'omitempty'")
+		}
+	}
+	zero, err := f.zeroCheck(v)
+	if err != nil {
+		return nil, fmt.Errorf("rule 'omitempty': %w", err)
+	}
+	code, err := f.genRules(v, rules)
+	if err != nil || len(code) == 0 {
+		return nil, err
+	}
+	return []string{f.check(negate(zero), strings.Join(code, "\n"))}, nil
+}
+
+// genDive 生成遍历切片、数组或 map 的循环，对每个元素（以及 keys...endkeys 指定的 map 键）应用剩余的规则。
+// 元素的错误路径形如 Tags[3]、Attrs[color]。
+func (f *file) genDive(v value, rules []vgenparser.Rule) (string, error) {
+	var keyRules []vgenparser.Rule
+	if len(rules) > 0 && rules[0].Name == "keys" {
+		end := 1
+		for rules[end].Name != "endkeys" {
+			end++
+		}
+		keyRules, rules = rules[1:end], rules[end+1:]
+	}
+	if len(rules) == 0 && len(keyRules) == 0 {
+		return "", fmt.Errorf("rule 'dive' must be followed by element rules")
+	}
+
+	suffix := ""
+	if v.depth > 0 {
+		suffix = strconv.Itoa(v.depth)
+	}
+	idx, key, elem := "i"+suffix, "k"+suffix, "v"+suffix
+
+	var elemType, keyType types.Type
+	var path string
+	switch u := v.typ.Underlying().(type) {
+	case *types.Slice:
+		elemType, path = u.Elem(), f.indexPath(v.path, idx)
+	case *types.Array:
+		elemType, path = u.Elem(), f.indexPath(v.path, idx)
+	case *types.Map:
+		elemType, keyType, path = u.Elem(), u.Key(), f.keyPath(v.path, key)
+		idx = key
+	default:
+		return "", fmt.Errorf("rule 'dive' is not applicable to type %s", f.typeString(v.typ))
+	}
+	if len(keyRules) > 0 && keyType == nil {
+		return "", fmt.Errorf("rule 'keys' is only applicable to maps, not %s", f.typeString(v.typ))
+	}
+
+	var body []string
+	if len(keyRules) > 0 {
+		code, err := f.genRules(value{expr: key, typ: keyType, name: v.name, path: path, depth: v.depth + 1}, keyRules)
+		if err != nil {
+			return "", fmt.Errorf("map keys: %w", err)
+		}
+		body = append(body, code...)
+	}
+	rangeVars := idx
+	if len(rules) > 0 {
+		code, err := f.genRules(value{expr: elem, typ: elemType, name: v.name, path: path, depth: v.depth + 1}, rules)
+		if err != nil {
+			return "", fmt.Errorf("elements: %w", err)
+		}
+		body = append(body, code...)
+		rangeVars += ", " + elem
+	}
+
+	return fmt.Sprintf("for %s := range %s {\n%s\n}", rangeVars, v.expr, strings.Join(body, "\n")), nil
+}
+
+// genRule 为单条规则生成校验代码片段
+func (f *file) genRule(v value, rule vgenparser.Rule) (string, error) {
+	k := kindOf(v.typ)
+
+	switch rule.Name {
+	case "required":
+		cond, err := f.zeroCheck(v)
+		if err != nil {
+			return "", err
+		}
+		return f.check(cond, f.fail(v, rule, "is required")), nil
+	case "min", "max":
+		return f.genBound(v, rule)
+	case "gt", "gte", "lt", "lte":
+		if !k.isNumeric() {
+			return "", f.notApplicable(rule, v)
+		}
+		return f.genCompare(v, rule, compareOps[rule.Name].op, compareOps[rule.Name].desc)
+	case "eq", "ne":
+		// eq/ne 还可以用于 string 和 bool
+		if !k.isNumeric() && k != kindString && k != kindBool {
+			return "", f.notApplicable(rule, v)
+		}
+		return f.genCompare(v, rule, compareOps[rule.Name].op, compareOps[rule.Name].desc)
+	case "len":
+		// len 规则适用于 string、slice、array 和 map
+		if !k.hasLen() {
+			return "", f.notApplicable(rule, v)
+		}
+		return f.genLength(v, rule, "!=", "", f.p.runes)
+	case "runemin", "runemax", "runelen":
+		if k != kindString {
+			return "", f.notApplicable(rule, v)
+		}
+		switch rule.Name {
+		case "runemin":
+			return f.genLength(v, rule, "<", "at least ", true)
+		case "runemax":
+			return f.genLength(v, rule, ">", "at most ", true)
+		}
+		return f.genLength(v, rule, "!=", "", true)
+	case "email", "url", "uri", "uuid", "ip", "ipv4", "ipv6", "cidr", "hostname", "mac",
+		"alpha", "alphanum", "numeric", "ascii", "printascii", "lowercase", "uppercase",
+		"json", "base64", "base64url", "hex", "utf8", "semver", "e164":
+		if k != kindString {
+			return "", f.notApplicable(rule, v)
+		}
+		sc := stringChecks[rule.Name]
+		f.useHelper(sc.helper)
+		return f.check(fmt.Sprintf("!%s(%s)", sc.helper, v.stringExpr()), f.fail(v, rule, sc.msg)), nil
+	case "iso3166", "iso3166_alpha3", "iso4217", "bcp47",
+		"luhn", "iban", "isbn", "isbn10", "isbn13", "ean":
+		if k != kindString {
+			return "", f.notApplicable(rule, v)
+		}
+		rc := runtimeChecks[rule.Name]
+		f.use(rc.path)
+		return f.check(fmt.Sprintf("!%s.%s(%s)", path.Base(rc.path), rc.fn, v.stringExpr()), f.fail(v, rule, rc.msg)), nil
+	case "contains", "excludes", "startswith", "endswith", "containsany", "excludesall":
+		if k != kindString {
+			return "", f.notApplicable(rule, v)
+		}
+		return f.genSubstring(v, rule)
+	case "pattern":
+		if k != kindString {
+			return "", f.notApplicable(rule, v)
+		}
+		// 正则中的 '|' 是选择符：使用未拆分的原始值，保留空的选择分支和分支两侧的空格
+		rule.Value = rule.Raw
+		// 在生成阶段编译，尽早发现错误的表达式
+		if _, err := regexp.Compile(rule.Value); err != nil {
+			return "", fmt.Errorf("invalid 'pattern' value: %w", err)
+		}
+		re := f.usePattern(rule.Value)
+		return f.check(fmt.Sprintf("!%s.MatchString(%s)", re, v.stringExpr()),
+			f.fail(v, rule, "must match the pattern "+rule.Value)), nil
+	case "future", "past", "after", "before", "within":
+		return f.genTimeRule(v, rule)
+	case "datetime":
+		return f.genDatetime(v, rule)
+	case "unique":
+		return f.genUnique(v, rule)
+	case "in":
+		return f.genIn(v, rule)
+	case "enum":
+		return f.genEnum(v, rule)
+	case "eqfield", "nefield", "gtfield", "gtefield", "ltfield", "ltefield":
+		return f.genCrossField(v, rule)
+	case "required_if", "required_unless", "required_with", "required_without":
+		return f.genRequiredIf(v, rule)
+	default:
+		return "", fmt.Errorf("unknown rule %s", rule.Name)
+	}
+}
+
+// genBound 生成 min/max 规则：数值类型比较值本身，string、slice、array 和 map 比较长度
+func (f *file) genBound(v value, rule vgenparser.Rule) (string, error) {
+	op, word := "<", "at least"
+	if rule.Name == "max" {
+		op, word = ">", "at most"
+	}
+
+	switch k := kindOf(v.typ); {
+	case k.hasLen():
+		return f.genLength(v, rule, op, word+" ", f.p.runes)
+	case k.isNumeric():
+		return f.genCompare(v, rule, op, "must be "+word)
+	default:
+		return "", f.notApplicable(rule, v)
+	}
+}
+
+// stringChecks 把只作用于字符串的规则映射到执行检查的共享辅助函数和错误信息
+var stringChecks = map[string]struct{ helper, msg string }{
+	"email":    {"vgenIsEmailValid", "is not a valid email"},
+	"url":      {"vgenIsURL", "is not a valid URL"},
+	"uri":      {"vgenIsURI", "is not a valid URI"},
+	"uuid":     {"vgenIsUUID", "is not a valid UUID"},
+	"ip":       {"vgenIsIP", "is not a valid IP address"},
+	"ipv4":     {"vgenIsIPv4", "is not a valid IPv4 address"},
+	"ipv6":     {"vgenIsIPv6", "is not a valid IPv6 address"},
+	"cidr":     {"vgenIsCIDR", "is not a valid CIDR prefix"},
+	"hostname": {"vgenIsHostname", "is not a valid hostname"},
+	"mac":      {"vgenIsMAC", "is not a valid MAC address"},
+
+	// 字符类规则
+	"alpha":      {"vgenIsAlpha", "must contain only ASCII letters"},
+	"alphanum":   {"vgenIsAlphanum", "must contain only ASCII letters and digits"},
+	"numeric":    {"vgenIsNumeric", "must contain only digits"},
+	"ascii":      {"vgenIsASCII", "must contain only ASCII characters"},
+	"printascii": {"vgenIsPrintASCII", "must contain only printable ASCII characters"},
+	"lowercase":  {"vgenIsLowercase", "must not contain uppercase letters"},
+	"uppercase":  {"vgenIsUppercase", "must not contain lowercase letters"},
+
+	// 编码规则
+	"json":      {"vgenIsJSON", "is not valid JSON"},
+	"base64":    {"vgenIsBase64", "is not valid base64"},
+	"base64url": {"vgenIsBase64URL", "is not valid URL-safe base64"},
+	"hex":       {"vgenIsHex", "is not a valid hexadecimal string"},
+	"utf8":      {"vgenIsUTF8", "is not valid UTF-8"},
+
+	// 标识符规则
+	"semver": {"vgenIsSemver", "is not a valid semantic version"},
+	"e164":   {"vgenIsE164", "is not a valid E.164 phone number"},
+}
+
+// runtimeChecks 把由 vgen 运行时包实现的字符串规则映射到包的导入路径、函数名和错误信息
+var runtimeChecks = map[string]struct{ path, fn, msg string }{
+	// 代码表规则
+	"iso3166":        {codesPath, "IsCountryCode", "is not a valid ISO 3166-1 alpha-2 country code"},
+	"iso3166_alpha3": {codesPath, "IsCountryCode3", "is not a valid ISO 3166-1 alpha-3 country code"},
+	"iso4217":        {codesPath, "IsCurrencyCode", "is not a valid ISO 4217 currency code"},
+	"bcp47":          {codesPath, "IsLanguageTag", "is not a valid BCP 47 language tag"},
+
+	// 校验位规则
+	"luhn":   {checksumPath, "IsLuhn", "failed the Luhn checksum"},
+	"iban":   {checksumPath, "IsIBAN", "is not a valid IBAN"},
+	"isbn":   {checksumPath, "IsISBN", "is not a valid ISBN"},
+	"isbn10": {checksumPath, "IsISBN10", "is not a valid ISBN-10"},
+	"isbn13": {checksumPath, "IsISBN13", "is not a valid ISBN-13"},
+	"ean":    {checksumPath, "IsEAN", "is not a valid EAN-8 or EAN-13 barcode"},
+}
+
+// genLength 生成长度比较：op 是校验失败时成立的运算符，word 是错误信息中的 "at least " 等限定词。
+// runes 为 true 时字符串按 Unicode 字符（rune）而不是字节计数。
+func (f *file) genLength(v value, rule vgenparser.Rule, op, word string, runes bool) (string, error) {
+	n, err := rule.GetIntValue()
+	if err != nil {
+		return "", fmt.Errorf("invalid '%s' value: %w", rule.Name, err)
+	}
+	if n < 0 {
+		return "", fmt.Errorf("invalid '%s' value: length cannot be negative", rule.Name)
+	}
+	lenExpr, unit := "len("+v.expr+")", ""
+	if runes && kindOf(v.typ) == kindString {
+		f.use("unicode/utf8")
+		lenExpr, unit = "utf8.RuneCountInString("+v.stringExpr()+")", " characters"
+	}
+	return f.check(fmt.Sprintf("%s %s %d", lenExpr, op, n),
+		f.fail(v, rule, "length must be "+word+"%d"+unit+", got %d", strconv.Itoa(n), lenExpr)), nil
+}
+
+// substringOps 把子串规则映射到 strings 包中的函数；negate 表示函数返回 true 时校验失败
+var substringOps = map[string]struct {
+	fn     string
+	negate bool
+	desc   string
+}{
+	"contains":    {"strings.Contains", false, "must contain"},
+	"excludes":    {"strings.Contains", true, "must not contain"},
+	"startswith":  {"strings.HasPrefix", false, "must start with"},
+	"endswith":    {"strings.HasSuffix", false, "must end with"},
+	"containsany": {"strings.ContainsAny", false, "must contain at least one of the characters"},
+	"excludesall": {"strings.ContainsAny", true, "must not contain any of the characters"},
+}
+
+// genSubstring 生成基于 strings 包的子串规则。
+// contains、startswith 和 endswith 的多个值（用 '|' 分隔）满足其一即可，excludes 要求一个都不包含；
+// containsany 和 excludesall 的值是字符集合，'|' 本身需要转义。
+func (f *file) genSubstring(v value, rule vgenparser.Rule) (string, error) {
+	spec := substringOps[rule.Name]
+	args := rule.Values
+	if rule.Name == "containsany" || rule.Name == "excludesall" {
+		args = []string{strings.Join(rule.Values, "")}
+	}
+	if len(args) == 0 || args[0] == "" {
+		return "", fmt.Errorf("rule '%s' requires a non-empty value", rule.Name)
+	}
+
+	calls := make([]string, len(args))
+	for i, arg := range args {
+		calls[i] = fmt.Sprintf("%s(%s, %q)", spec.fn, v.stringExpr(), arg)
+	}
+	cond := strings.Join(calls, " || ")
+	switch {
+	case spec.negate:
+	case len(calls) == 1:
+		cond = "!" + cond
+	default:
+		cond = "!(" + cond + ")"
+	}
+
+	desc := spec.desc + " " + strconv.Quote(args[0])
+	if len(args) > 1 {
+		quoted := make([]string, len(args))
+		for i, arg := range args {
+			quoted[i] = strconv.Quote(arg)
+		}
+		word := "one"
+		if spec.negate {
+			word = "any"
+		}
+		desc = spec.desc + " " + word + " of " + strings.Join(quoted, ", ")
+	}
+	f.use("strings")
+	return f.check(cond, f.fail(v, rule, desc)), nil
+}
+
+// genUnique 生成 unique 规则：切片和数组报告第一个与前面元素重复的元素的下标，例如 Tags[3]；
+// map 报告值与其它键重复的某个键。unique=Field 用于结构体切片，按结构体的字段判断是否重复。
+func (f *file) genUnique(v value, rule vgenparser.Rule) (string, error) {
+	var elem types.Type
+	switch u := v.typ.Underlying().(type) {
+	case *types.Slice:
+		elem = u.Elem()
+	case *types.Array:
+		elem = u.Elem()
+	case *types.Map:
+		if rule.Value != "" {
+			return "", fmt.Errorf("rule 'unique=%s' is only applicable to slices and arrays of structs", rule.Value)
+		}
+		if !types.Comparable(u.Elem()) {
+			return "", fmt.Errorf("rule 'unique': map values of type %s are not comparable", f.typeString(u.Elem()))
+		}
+		// 键可排序时按键的顺序查找，报告的键是确定的；否则只报告整个字段
+		if k := kindOf(u.Key()); k != kindString && !k.isNumeric() {
+			f.useHelper("vgenHasDuplicateMapValue")
+			return f.check(fmt.Sprintf("vgenHasDuplicateMapValue(%s)", v.expr), f.fail(v, rule, "contains duplicate values")), nil
+		}
+		f.useHelper("vgenDuplicateMapValue")
+		dup := value{expr: v.operand() + "[dup]", typ: u.Elem(), name: v.name, path: f.keyPath(v.path, "dup")}
+		return fmt.Sprintf("if dup, ok := vgenDuplicateMapValue(%s); ok {\n%s\n}", v.expr, f.fail(dup, rule, "duplicate value")), nil
+	default:
+		return "", f.notApplicable(rule, v)
+	}
+
+	list := v.expr
+	if kindOf(v.typ) == kindArray {
+		list = v.operand() + "[:]"
+	}
+	dup := value{expr: v.operand() + "[dup]", typ: elem, name: v.name, path: f.indexPath(v.path, "dup")}
+
+	if rule.Value == "" {
+		if !types.Comparable(elem) {
+			return "", fmt.Errorf("rule 'unique': elements of type %s are not comparable", f.typeString(elem))
+		}
+		f.useHelper("vgenFirstDuplicate")
+		return fmt.Sprintf("if dup := vgenFirstDuplicate(%s); dup >= 0 {\n%s\n}", list, f.fail(dup, rule, "duplicate value")), nil
+	}
+
+	// unique=Field：按元素的某个字段判断是否重复，错误路径指向该字段，例如 Items[3].SKU
+	if _, ok := elem.Underlying().(*types.Struct); !ok {
+		return "", fmt.Errorf("rule 'unique=%s' is only applicable to slices and arrays of structs, not %s", rule.Value, f.typeString(v.typ))
+	}
+	obj, _, indirect := types.LookupFieldOrMethod(elem, false, f.pkg, rule.Value)
+	field, ok := obj.(*types.Var)
+	if !ok || !field.IsField() || indirect || !token.IsIdentifier(rule.Value) {
+		return "", fmt.Errorf("rule 'unique': %s has no field %s", f.typeString(elem), rule.Value)
+	}
+	if !types.Comparable(field.Type()) {
+		return "", fmt.Errorf("rule 'unique': field %s of type %s is not comparable", rule.Value, f.typeString(field.Type()))
+	}
+	f.useHelper("vgenFirstDuplicateBy")
+	key := fmt.Sprintf("func(e %s) %s { return e.%s }", f.typeExpr(elem), f.typeExpr(field.Type()), rule.Value)
+	dup.expr, dup.typ, dup.path = dup.expr+"."+rule.Value, field.Type(), dup.path+` + ".`+rule.Value+`"`
+	return fmt.Sprintf("if dup := vgenFirstDuplicateBy(%s, %s); dup >= 0 {\n%s\n}", list, key, f.fail(dup, rule, "duplicate value")), nil
+}
+
+// genIn 生成 in 规则：值列表在生成时按字段的类型检查，并生成一个 switch 语句。
+// 命名类型（例如 type Status int）的值还可以是与之同类型的常量名，例如 in=StatusActive|StatusPending。
+func (f *file) genIn(v value, rule vgenparser.Rule) (string, error) {
+	if k := kindOf(v.typ); k != kindString && !k.isNumeric() {
+		return "", f.notApplicable(rule, v)
+	}
+	if len(rule.Values) == 0 {
+		return "", fmt.Errorf("invalid 'in' value: empty list")
+	}
+
+	var cases []string
+	var seen []constant.Value
+	for _, item := range rule.Values {
+		expr, val, err := f.inValue(v.typ, item)
+		if err != nil {
+			return "", fmt.Errorf("invalid 'in' value: %w", err)
+		}
+		for _, prev := range seen {
+			if constant.Compare(prev, token.EQL, val) {
+				return "", fmt.Errorf("invalid 'in' value: duplicate value '%s'", item)
+			}
+		}
+		seen = append(seen, val)
+		cases = append(cases, expr)
+	}
+
+	return fmt.Sprintf("switch %s {\ncase %s:\ndefault:\n%s\n}", v.expr, strings.Join(cases, ", "),
+		f.fail(v, rule, "value '%v' is not in the allowed list [%s]", v.expr, strconv.Quote(strings.Join(rule.Values, ", ")))), nil
+}
+
+// genEnum 生成 enum 规则：收集字段的命名类型在其所在包中声明的所有同类型常量，生成与 in 相同的 switch 语句。
+// 新增常量后重新生成即可更新校验；值相同的常量（例如别名常量）只保留最先声明的一个。
+func (f *file) genEnum(v value, rule vgenparser.Rule) (string, error) {
+	if rule.Value != "" {
+		return "", fmt.Errorf("rule 'enum' takes no value")
+	}
+	named, ok := types.Unalias(v.typ).(*types.Named)
+	if k := kindOf(v.typ); !ok || named.Obj().Pkg() == nil || (k != kindString && !k.isNumeric()) {
+		return "", fmt.Errorf("rule 'enum' requires a named string or numeric type, got %s", f.typeString(v.typ))
+	}
+
+	pkg := named.Obj().Pkg()
+	var consts []*types.Const
+	for _, name := range pkg.Scope().Names() {
+		c, ok := pkg.Scope().Lookup(name).(*types.Const)
+		if !ok || !types.Identical(c.Type(), v.typ) || (pkg != f.pkg && !c.Exported()) {
+			continue
+		}
+		consts = append(consts, c)
+	}
+	if len(consts) == 0 {
+		return "", fmt.Errorf("rule 'enum': type %s has no constants", f.typeString(v.typ))
+	}
+	// 按声明顺序排列，保证生成结果稳定且与源码一致
+	sort.Slice(consts, func(i, j int) bool { return consts[i].Pos() < consts[j].Pos() })
+
+	var cases []string
+	var seen []constant.Value
+	for _, c := range consts {
+		if slices.ContainsFunc(seen, func(prev constant.Value) bool { return constant.Compare(prev, token.EQL, c.Val()) }) {
+			continue
+		}
+		seen = append(seen, c.Val())
+		if pkg == f.pkg {
+			cases = append(cases, c.Name())
+		} else {
+			f.use(pkg.Path())
+			cases = append(cases, pkg.Name()+"."+c.Name())
+		}
+	}
+
+	return fmt.Sprintf("switch %s {\ncase %s:\ndefault:\n%s\n}", v.expr, strings.Join(cases, ", "),
+		f.fail(v, rule, "value '%v' is not a valid "+named.Obj().Name(), v.expr)), nil
+}
+
+// inValue 把 in 规则的一个值转换为 case 表达式及其常量值，用于检查重复
+func (f *file) inValue(t types.Type, item string) (string, constant.Value, error) {
+	if named, ok := types.Unalias(t).(*types.Named); ok && token.IsIdentifier(item) {
+		if pkg := named.Obj().Pkg(); pkg != nil {
+			if c, ok := pkg.Scope().Lookup(item).(*types.Const); ok && types.Identical(c.Type(), t) {
+				if pkg == f.pkg {
+					return item, c.Val(), nil
+				}
+				f.use(pkg.Path())
+				return pkg.Name() + "." + item, c.Val(), nil
+			}
+		}
+	}
+
+	// 时长渲染为 time.Hour 这样的表达式，不能再按字面量解析，直接用解析出的纳秒数比较
+	if isNamed(t, "time", "Duration") {
+		if d, err := time.ParseDuration(item); err == nil {
+			return f.durationExpr(d), constant.MakeInt64(int64(d)), nil
+		}
+	}
+
+	lit, err := f.literal(t, item)
+	if err != nil {
+		return "", nil, err
+	}
+	tok := token.INT
+	switch kindOf(t) {
+	case kindString:
+		tok = token.STRING
+	case kindFloat:
+		tok = token.FLOAT
+	}
+	return lit, constant.MakeFromLiteral(lit, tok, 0), nil
+}
+
+// compareOps 把数值比较规则映射到校验失败的比较运算符和错误描述
+var compareOps = map[string]struct{ op, desc string }{
+	"gt":  {"<=", "must be greater than"},
+	"gte": {"<", "must be greater than or equal to"},
+	"lt":  {">=", "must be less than"},
+	"lte": {">", "must be less than or equal to"},
+	"eq":  {"!=", "must be equal to"},
+	"ne":  {"==", "must not be equal to"},
+}
+
+// genCompare 生成值与标签中字面量的比较：op 是校验失败时成立的运算符。
+// 字面量按字段的类型解析，超出类型范围或格式不合法时在生成阶段报错。
+func (f *file) genCompare(v value, rule vgenparser.Rule, op, desc string) (string, error) {
+	lit, err := f.literal(v.typ, rule.Value)
+	if err != nil {
+		return "", fmt.Errorf("rule '%s': %w", rule.Name, err)
+	}
+	cond := fmt.Sprintf("%s %s %s", v.expr, op, lit)
+	display := lit
+	if _, err := time.ParseDuration(rule.Value); err == nil && isNamed(v.typ, "time", "Duration") {
+		display = rule.Value
+	}
+	verb := "%v"
+	switch kindOf(v.typ) {
+	case kindString:
+		verb = "%q"
+	case kindBool:
+		// 避免生成 x != true 这样的比较
+		cond = v.expr
+		if (op == "!=") == (lit == "true") {
+			cond = "!" + v.expr
+		}
+	}
+	return f.check(cond,
+		f.fail(v, rule, desc+" "+strings.ReplaceAll(display, "%", "%%")+", got "+verb, v.expr)), nil
+}
+
+// zeroCheck 返回判断值是否为其类型零值的条件表达式
+func (f *file) zeroCheck(v value) (string, error) {
+	if isNamed(v.typ, "time", "Time") {
+		return v.recv() + ".IsZero()", nil
+	}
+
+	switch kindOf(v.typ) {
+	case kindString:
+		return v.expr + ` == ""`, nil
+	case kindInt, kindUint, kindFloat, kindComplex:
+		return v.expr + " == 0", nil
+	case kindBool:
+		return "!" + v.expr, nil
+	case kindPointer, kindInterface, kindChan, kindFunc:
+		return v.expr + " == nil", nil
+	case kindSlice, kindMap:
+		return "len(" + v.expr + ") == 0", nil
+	case kindArray, kindStruct:
+		if types.Comparable(v.typ) {
+			return fmt.Sprintf("%s == (%s{})", v.expr, f.typeExpr(v.typ)), nil
+		}
+	}
+	return "", fmt.Errorf("cannot determine the zero value of type %s", f.typeString(v.typ))
+}
+
+// negate 返回条件表达式的否定形式；能直接反转比较运算符时不额外加括号
+func negate(cond string) string {
+	if strings.Contains(cond, "&&") || strings.Contains(cond, "||") {
+		return "!(" + cond + ")"
+	}
+	if strings.Count(cond, " == ") == 1 {
+		return strings.Replace(cond, " == ", " != ", 1)
+	}
+	if strings.HasPrefix(cond, "!") && !strings.ContainsAny(cond[1:], " !") {
+		return cond[1:]
+	}
+	if !strings.Contains(cond, " ") {
+		return "!" + cond
+	}
+	return "!(" + cond + ")"
+}
+
+// check 生成 "if cond { stmt }" 语句
+func (f *file) check(cond, stmt string) string {
+	return fmt.Sprintf("if %s {\n%s\n}", cond, stmt)
+}
+
+// fail 生成把 *verr.FieldError 追加到 errs 的语句。
+// msg 是错误描述，其中的 %s/%d 等占位符依次对应 args 中的 Go 表达式。
+func (f *file) fail(v value, rule vgenparser.Rule, msg string, args ...string) string {
+	f.use(verrPath)
+	msgExpr := strconv.Quote(msg)
+	if len(args) > 0 {
+		f.use("fmt")
+		msgExpr = fmt.Sprintf("fmt.Sprintf(%s, %s)", msgExpr, strings.Join(args, ", "))
+	}
+	param := ""
+	if rule.Value != "" {
+		param = fmt.Sprintf(" Param: %q,", rule.Value)
+	}
+	return fmt.Sprintf("errs = append(errs, &verr.FieldError{\nPath: %s, Field: %q, Rule: %q,%s Value: %s,\nMsg: %s,\n})",
+		v.path, v.name, rule.Name, param, v.expr, msgExpr)
+}
+
+// notApplicable 返回规则不适用于字段类型的错误
+func (f *file) notApplicable(rule vgenparser.Rule, v value) error {
+	return fmt.Errorf("rule '%s' is not applicable to type %s", rule.Name, f.typeString(v.typ))
+}
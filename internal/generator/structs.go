@@ -0,0 +1,333 @@
+package generator
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"reflect"
+	"strconv"
+
+	"golang.org/x/tools/go/packages"
+
+	vgenparser "github.com/hiramkuang/vgen/internal/parser"
+)
+
+// pkgState 保存同一个包内所有文件共享的生成状态
+type pkgState struct {
+	pkg        *packages.Package
+	files      []*ast.File              // 参与生成的源文件（不含 _test.go、vendor 和已生成的文件）
+	paths      []string                 // files 对应的文件路径
+	structs    []*types.TypeName        // files 中声明的结构体
+	helpers    map[string]bool          // 用到的共享辅助函数，最终写入 vgen_helpers.go
+	validators map[*types.TypeName]bool // 将生成 Validate() 的结构体
+	custom     map[string]bool          // 已有手写 Validate() error 方法的类型名，可以被嵌套校验
+	declared   map[string]bool          // 已声明任意签名的 Validate 方法的类型名，不能再为它们生成
+	patterns   []string                 // pattern 规则用到的正则表达式，按首次出现的顺序编号
+	runes      bool                     // 字符串的 min/max/len 是否按 rune 计数
+	all        map[string]*pkgState     // 本次加载的所有包，按导入路径索引（包括自身）
+}
+
+func newPkgState(pkg *packages.Package, runes bool) *pkgState {
+	p := &pkgState{
+		pkg:        pkg,
+		runes:      runes,
+		helpers:    make(map[string]bool),
+		validators: make(map[*types.TypeName]bool),
+		custom:     make(map[string]bool),
+		declared:   make(map[string]bool),
+	}
+	p.all = map[string]*pkgState{pkg.PkgPath: p}
+	return p
+}
+
+// scan 找出包内带有 vgen 标签、需要生成 Validate() 的结构体。
+// 包含这类结构体的结构体由 propagate 在所有包都扫描完之后补充。
+func (p *pkgState) scan() error {
+	for _, node := range p.files {
+		for _, decl := range node.Decls {
+			// 记录手写的 Validate 方法：这些类型不能再生成，签名为 Validate() error 的还可以被嵌套校验
+			if fn, ok := decl.(*ast.FuncDecl); ok && fn.Name.Name == "Validate" && fn.Recv != nil {
+				if name := receiverName(fn.Recv.List[0].Type); name != "" {
+					p.declared[name] = true
+					if obj, ok := p.pkg.TypesInfo.Defs[fn.Name].(*types.Func); ok && isValidateFunc(obj) {
+						p.custom[name] = true
+					}
+				}
+				continue
+			}
+			p.structs = append(p.structs, p.structDecls(decl)...)
+		}
+	}
+
+	for _, obj := range p.structs {
+		if hasTags(obj.Type().Underlying().(*types.Struct)) {
+			if p.declared[obj.Name()] {
+				return fmt.Errorf("type %s has vgen tags but already declares a Validate method", obj.Name())
+			}
+			p.validators[obj] = true
+		}
+	}
+	return nil
+}
+
+// propagate 把（直接或通过指针、切片、数组、map、嵌入）包含可校验结构体的结构体标记为需要生成 Validate()，
+// 返回是否有新的结构体被标记。被包含的结构体可能来自本次加载的其它包，因此需要对所有包反复调用直到不再变化。
+func (p *pkgState) propagate() bool {
+	changed := false
+	for again := true; again; {
+		again = false
+		for _, obj := range p.structs {
+			if p.validators[obj] || p.declared[obj.Name()] {
+				continue
+			}
+			st := obj.Type().Underlying().(*types.Struct)
+			for i := 0; i < st.NumFields(); i++ {
+				if tagOf(st, i) != "-" && p.nestedKind(st.Field(i).Type()) != nestNone {
+					p.validators[obj] = true
+					changed, again = true, true
+					break
+				}
+			}
+		}
+	}
+	return changed
+}
+
+// structDecls 返回声明中定义的结构体类型；别名和泛型结构体不生成 Validate()
+func (p *pkgState) structDecls(decl ast.Decl) []*types.TypeName {
+	genDecl, ok := decl.(*ast.GenDecl)
+	if !ok || genDecl.Tok != token.TYPE {
+		return nil
+	}
+
+	var objs []*types.TypeName
+	for _, spec := range genDecl.Specs {
+		typeSpec, ok := spec.(*ast.TypeSpec)
+		if !ok || typeSpec.Assign.IsValid() || typeSpec.TypeParams != nil {
+			continue
+		}
+		if _, ok := typeSpec.Type.(*ast.StructType); !ok {
+			continue
+		}
+		if obj, ok := p.pkg.TypesInfo.Defs[typeSpec.Name].(*types.TypeName); ok {
+			if _, ok := obj.Type().Underlying().(*types.Struct); ok {
+				objs = append(objs, obj)
+			}
+		}
+	}
+	return objs
+}
+
+// receiverName 返回方法接收者的类型名，例如 *User 返回 "User"
+func receiverName(expr ast.Expr) string {
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+	if ident, ok := expr.(*ast.Ident); ok {
+		return ident.Name
+	}
+	return ""
+}
+
+// tagOf 返回结构体第 i 个字段的 vgen 标签
+func tagOf(st *types.Struct, i int) string {
+	return reflect.StructTag(st.Tag(i)).Get("vgen")
+}
+
+// hasTags 判断结构体是否有字段带有 vgen 规则
+func hasTags(st *types.Struct) bool {
+	for i := 0; i < st.NumFields(); i++ {
+		if tag := tagOf(st, i); tag != "" && tag != "-" {
+			return true
+		}
+	}
+	return false
+}
+
+// hasValidator 判断类型 t 是否有可调用的 Validate() error 方法（已有或即将生成）
+func (p *pkgState) hasValidator(t types.Type) bool {
+	named, ok := types.Unalias(t).(*types.Named)
+	if !ok {
+		return false
+	}
+	obj := named.Obj()
+	if obj.Pkg() == nil {
+		return false
+	}
+	if q := p.all[obj.Pkg().Path()]; q != nil {
+		// 本次加载的包以本次生成的结果为准，忽略过时的生成文件中的方法，
+		// 也不依赖其它包之前是否已经生成过
+		local, _ := q.pkg.Types.Scope().Lookup(obj.Name()).(*types.TypeName)
+		return q.validators[local] || q.custom[obj.Name()]
+	}
+
+	m, _, _ := types.LookupFieldOrMethod(types.NewPointer(named), true, obj.Pkg(), "Validate")
+	fn, ok := m.(*types.Func)
+	return ok && isValidateFunc(fn)
+}
+
+// isValidateFunc 判断方法的签名是否为 Validate() error，其它签名的 Validate 不能用于嵌套校验
+func isValidateFunc(fn *types.Func) bool {
+	sig := fn.Type().(*types.Signature)
+	return sig.Params().Len() == 0 && sig.Results().Len() == 1 &&
+		types.Identical(sig.Results().At(0).Type(), types.Universe.Lookup("error").Type())
+}
+
+// nestKind 描述字段中可校验的嵌套结构体的位置
+type nestKind int
+
+const (
+	nestNone        nestKind = iota
+	nestValue                // T
+	nestPointer              // *T
+	nestElem                 // []T、[N]T
+	nestElemPtr              // []*T、[N]*T
+	nestMapValue             // map[K]T
+	nestMapValuePtr          // map[K]*T
+)
+
+// nestedKind 判断字段类型 t 中是否包含有 Validate() 方法的结构体
+func (p *pkgState) nestedKind(t types.Type) nestKind {
+	if p.hasValidator(t) {
+		return nestValue
+	}
+
+	var elem types.Type
+	var elemKind, ptrKind nestKind
+	switch u := t.Underlying().(type) {
+	case *types.Pointer:
+		if p.hasValidator(u.Elem()) {
+			return nestPointer
+		}
+		return nestNone
+	case *types.Slice:
+		elem, elemKind, ptrKind = u.Elem(), nestElem, nestElemPtr
+	case *types.Array:
+		elem, elemKind, ptrKind = u.Elem(), nestElem, nestElemPtr
+	case *types.Map:
+		elem, elemKind, ptrKind = u.Elem(), nestMapValue, nestMapValuePtr
+	default:
+		return nestNone
+	}
+
+	if p.hasValidator(elem) {
+		return elemKind
+	}
+	if ptr, ok := elem.Underlying().(*types.Pointer); ok && p.hasValidator(ptr.Elem()) {
+		return ptrKind
+	}
+	return nestNone
+}
+
+// collectStructs 收集文件中需要生成 Validate() 的结构体及其校验代码
+func (f *file) collectStructs(node *ast.File) ([]StructInfo, error) {
+	var structInfos []StructInfo
+
+	for _, decl := range node.Decls {
+		for _, obj := range f.p.structDecls(decl) {
+			if !f.p.validators[obj] {
+				continue
+			}
+			structInfo, err := f.collectFields(obj.Name(), obj.Type().Underlying().(*types.Struct))
+			if err != nil {
+				return nil, err
+			}
+			structInfos = append(structInfos, structInfo)
+		}
+	}
+
+	return structInfos, nil
+}
+
+// collectFields 解析结构体各字段的 vgen 标签，并根据字段的类型信息为每条规则生成校验代码；
+// 包含可校验结构体的字段还会递归调用其 Validate()
+func (f *file) collectFields(structName string, structType *types.Struct) (StructInfo, error) {
+	structInfo := StructInfo{Name: structName}
+	f.cur = structType
+
+	for i := 0; i < structType.NumFields(); i++ {
+		field := structType.Field(i)
+		fieldName := field.Name()
+
+		// vgen:"-" 表示完全跳过该字段，包括嵌套校验
+		tagValue := tagOf(structType, i)
+		if tagValue == "-" {
+			continue
+		}
+
+		if isInvalid(field.Type()) {
+			if tagValue == "" {
+				continue
+			}
+			return StructInfo{}, fmt.Errorf("cannot resolve type of field %s.%s", structName, fieldName)
+		}
+
+		// 使用我们的 parser 解析 tag
+		rules, err := vgenparser.ParseTag(tagValue)
+		if err != nil {
+			return StructInfo{}, fmt.Errorf("error parsing tag for field %s.%s: %w", structName, fieldName, err)
+		}
+
+		v := value{expr: "s." + fieldName, typ: field.Type(), name: fieldName, path: strconv.Quote(fieldName)}
+		validators, err := f.genRules(v, rules)
+		if err != nil {
+			return StructInfo{}, fmt.Errorf("field %s.%s: %w", structName, fieldName, err)
+		}
+		if code := f.genNested(v, field.Embedded()); code != "" {
+			validators = append(validators, code)
+		}
+		if len(validators) == 0 {
+			continue
+		}
+
+		// 保存字段信息
+		structInfo.Fields = append(structInfo.Fields, FieldInfo{
+			Name:       fieldName,
+			Rules:      rules,
+			Validators: validators,
+		})
+	}
+
+	return structInfo, nil
+}
+
+// genNested 生成调用嵌套结构体 Validate() 的代码，错误路径形如 Address.Zip、Items[3].SKU。
+// 嵌入结构体的字段被提升到外层，因此其错误路径不加前缀。字段中没有可校验的结构体时返回空字符串。
+func (f *file) genNested(v value, embedded bool) string {
+	prefix := v.path
+	if embedded {
+		prefix = `""`
+	}
+	nest := func(path, expr string) string {
+		return fmt.Sprintf("errs = errs.Nest(%s, %q, %s.Validate())", path, v.name, expr)
+	}
+
+	switch f.p.nestedKind(v.typ) {
+	case nestValue:
+		return nest(prefix, v.expr)
+	case nestPointer:
+		return f.check(v.expr+" != nil", nest(prefix, v.expr))
+	case nestElem:
+		return fmt.Sprintf("for i := range %s {\n%s\n}", v.expr, nest(f.indexPath(v.path, "i"), v.expr+"[i]"))
+	case nestElemPtr:
+		return fmt.Sprintf("for i, e := range %s {\n%s\n}", v.expr, f.check("e != nil", nest(f.indexPath(v.path, "i"), "e")))
+	case nestMapValue:
+		return fmt.Sprintf("for k, e := range %s {\n%s\n}", v.expr, nest(f.keyPath(v.path, "k"), "e"))
+	case nestMapValuePtr:
+		return fmt.Sprintf("for k, e := range %s {\n%s\n}", v.expr, f.check("e != nil", nest(f.keyPath(v.path, "k"), "e")))
+	}
+	return ""
+}
+
+// indexPath 返回切片或数组元素路径的 Go 表达式，例如 verr.Index("Items", i)
+func (f *file) indexPath(path, index string) string {
+	f.use(verrPath)
+	return fmt.Sprintf("verr.Index(%s, %s)", path, index)
+}
+
+// keyPath 返回 map 元素路径的 Go 表达式，例如 verr.Key("Attrs", k)
+func (f *file) keyPath(path, key string) string {
+	f.use(verrPath)
+	return fmt.Sprintf("verr.Key(%s, %s)", path, key)
+}
@@ -0,0 +1,128 @@
+package generator
+
+import (
+	"fmt"
+	"time"
+
+	vgenparser "github.com/hiramkuang/vgen/internal/parser"
+)
+
+// timeLayouts 是 after/before 规则接受的时间格式，没有时区的时间按 UTC 处理
+var timeLayouts = []string{time.RFC3339Nano, "2006-01-02T15:04:05", "2006-01-02 15:04:05", "2006-01-02"}
+
+// genTimeRule 生成 time.Time 上的 future、past、after、before 和 within 规则。
+// 与当前时间比较的规则通过 vgen_helpers.go 中的 vgenNow 获取时间，测试中可以替换它。
+func (f *file) genTimeRule(v value, rule vgenparser.Rule) (string, error) {
+	if !isNamed(v.typ, "time", "Time") {
+		return "", f.notApplicable(rule, v)
+	}
+
+	switch rule.Name {
+	case "future", "past":
+		if rule.Value != "" {
+			return "", fmt.Errorf("rule '%s' takes no value", rule.Name)
+		}
+		f.useHelper("vgenNow")
+		if rule.Name == "future" {
+			return f.check(fmt.Sprintf("!%s.After(vgenNow())", v.recv()), f.fail(v, rule, "must be in the future")), nil
+		}
+		return f.check(fmt.Sprintf("!%s.Before(vgenNow())", v.recv()), f.fail(v, rule, "must be in the past")), nil
+	case "within":
+		d, err := time.ParseDuration(rule.Value)
+		if err != nil || d <= 0 {
+			return "", fmt.Errorf("invalid 'within' value '%s': must be a positive duration such as 720h", rule.Value)
+		}
+		f.useHelper("vgenNow")
+		f.useHelper("vgenIsWithin")
+		return f.check(fmt.Sprintf("!vgenIsWithin(%s, %s)", v.recv(), f.durationExpr(d)),
+			f.fail(v, rule, "must be within "+rule.Value+" of the current time")), nil
+	}
+
+	// after、before
+	t, err := parseTime(rule.Value)
+	if err != nil {
+		return "", fmt.Errorf("invalid '%s' value: %w", rule.Name, err)
+	}
+	method, word := "After", "after"
+	if rule.Name == "before" {
+		method, word = "Before", "before"
+	}
+	return f.check(fmt.Sprintf("!%s.%s(%s)", v.recv(), method, f.timeExpr(t)),
+		f.fail(v, rule, "must be "+word+" "+rule.Value)), nil
+}
+
+// genDatetime 生成 datetime=layout 规则：字符串必须能用 time.Parse 按 layout 解析。
+// layout 在生成时做一次格式化再解析的往返检查，拒绝不含任何时间元素或无法解析自身输出的 layout。
+func (f *file) genDatetime(v value, rule vgenparser.Rule) (string, error) {
+	if kindOf(v.typ) != kindString {
+		return "", f.notApplicable(rule, v)
+	}
+	if err := checkLayout(rule.Value); err != nil {
+		return "", fmt.Errorf("invalid 'datetime' value: %w", err)
+	}
+	f.use("time")
+	return f.check(fmt.Sprintf("_, err := time.Parse(%q, %s); err != nil", rule.Value, v.stringExpr()),
+		f.fail(v, rule, "must be a time in the layout "+rule.Value)), nil
+}
+
+// checkLayout 检查 layout 是否为有效的 Go 参考时间格式
+func checkLayout(layout string) error {
+	if layout == "" {
+		return fmt.Errorf("missing layout")
+	}
+	t1 := time.Date(2006, time.January, 2, 15, 4, 5, 123456789, time.UTC)
+	t2 := time.Date(1999, time.December, 31, 23, 59, 58, 0, time.UTC)
+	s := t1.Format(layout)
+	if s == t2.Format(layout) {
+		return fmt.Errorf("layout %q contains no date or time elements, see the time package for the reference time 2006-01-02T15:04:05Z07:00", layout)
+	}
+	if _, err := time.Parse(layout, s); err != nil {
+		return fmt.Errorf("layout %q cannot parse its own output: %w", layout, err)
+	}
+	return nil
+}
+
+// parseTime 按 timeLayouts 依次尝试解析标签中的时间
+func parseTime(s string) (time.Time, error) {
+	for _, layout := range timeLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("cannot parse '%s' as a time, use RFC 3339 or 2006-01-02", s)
+}
+
+// timeExpr 返回构造时间 t（转换为 UTC）的 Go 表达式
+func (f *file) timeExpr(t time.Time) string {
+	f.use("time")
+	t = t.UTC()
+	return fmt.Sprintf("time.Date(%d, time.%s, %d, %d, %d, %d, %d, time.UTC)",
+		t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond())
+}
+
+// durationExpr 返回时长 d 的 Go 表达式，尽量使用 time.Hour 等单位，例如 720 * time.Hour
+func (f *file) durationExpr(d time.Duration) string {
+	if d == 0 {
+		return "0"
+	}
+	f.use("time")
+	units := []struct {
+		d    time.Duration
+		name string
+	}{
+		{time.Hour, "time.Hour"},
+		{time.Minute, "time.Minute"},
+		{time.Second, "time.Second"},
+		{time.Millisecond, "time.Millisecond"},
+		{time.Microsecond, "time.Microsecond"},
+	}
+	for _, u := range units {
+		if d%u.d == 0 {
+			if d == u.d {
+				return u.name
+			}
+			return fmt.Sprintf("%d * %s", d/u.d, u.name)
+		}
+	}
+	return fmt.Sprintf("time.Duration(%d)", int64(d))
+}
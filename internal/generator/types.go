@@ -0,0 +1,92 @@
+package generator
+
+import (
+	"go/types"
+)
+
+// kind 是按底层类型归类后的字段种类，决定了规则生成什么样的代码。
+// 命名类型（type Email string）、别名和其它包中的类型都按其底层类型归类。
+type kind int
+
+const (
+	kindUnsupported kind = iota
+	kindString
+	kindInt   // 有符号整数
+	kindUint  // 无符号整数
+	kindFloat // 浮点数
+	kindComplex
+	kindBool
+	kindSlice
+	kindArray
+	kindMap
+	kindPointer
+	kindStruct
+	kindInterface
+	kindChan
+	kindFunc
+)
+
+// kindOf 返回类型 t 的种类
+func kindOf(t types.Type) kind {
+	switch u := t.Underlying().(type) {
+	case *types.Basic:
+		info := u.Info()
+		switch {
+		case info&types.IsString != 0:
+			return kindString
+		case info&types.IsUnsigned != 0:
+			return kindUint
+		case info&types.IsInteger != 0:
+			return kindInt
+		case info&types.IsFloat != 0:
+			return kindFloat
+		case info&types.IsComplex != 0:
+			return kindComplex
+		case info&types.IsBoolean != 0:
+			return kindBool
+		}
+	case *types.Slice:
+		return kindSlice
+	case *types.Array:
+		return kindArray
+	case *types.Map:
+		return kindMap
+	case *types.Pointer:
+		return kindPointer
+	case *types.Struct:
+		return kindStruct
+	case *types.Interface:
+		return kindInterface
+	case *types.Chan:
+		return kindChan
+	case *types.Signature:
+		return kindFunc
+	}
+	return kindUnsupported
+}
+
+// isNumeric 判断种类是否为整数或浮点数
+func (k kind) isNumeric() bool {
+	return k == kindInt || k == kindUint || k == kindFloat
+}
+
+// hasLen 判断该种类的值是否可以使用内置的 len()
+func (k kind) hasLen() bool {
+	return k == kindString || k == kindSlice || k == kindArray || k == kindMap
+}
+
+// isNamed 判断 t 是否为 pkgPath 包中名为 name 的命名类型，例如 time.Time
+func isNamed(t types.Type, pkgPath, name string) bool {
+	n, ok := types.Unalias(t).(*types.Named)
+	if !ok {
+		return false
+	}
+	obj := n.Obj()
+	return obj.Pkg() != nil && obj.Pkg().Path() == pkgPath && obj.Name() == name
+}
+
+// isInvalid 判断类型是否因类型检查错误而无法解析
+func isInvalid(t types.Type) bool {
+	b, ok := t.(*types.Basic)
+	return ok && b.Kind() == types.Invalid
+}
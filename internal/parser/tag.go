@@ -1,56 +1,239 @@
-// internal/parser/tag.go
 package parser
 
 import (
 	"fmt"
 	"strconv"
 	"strings"
+	"unicode"
 )
 
 // Rule 代表一个从 tag 中解析出的单条校验规则
 type Rule struct {
-	Name  string            // 规则名称，例如 "required", "min"
-	Value string            // 规则的值，例如 "2", "50"
-	Args  map[string]string // 未来可能支持的键值对参数 (预留)
+	Name   string            // 规则名称，例如 "required", "min"
+	Value  string            // 规则的值（已去除引号和转义），例如 "2", "50"
+	Values []string          // 按 '|' 拆分后的列表值，例如 in=a|b|c 得到 ["a", "b", "c"]
+	Raw    string            // 未按 '|' 拆分的完整值（已去除引号和转义），保留空元素和元素两侧的空格，供 pattern 使用
+	Args   map[string]string // 未来可能支持的键值对参数 (预留)
 }
 
-// ParseTag 解析 vgen tag 字符串，例如 `vgen:"required,min=2,max=50"`
+// ParseTag 解析 vgen tag 字符串，例如 `vgen:"required,min=2,max=50"`。
+//
+// 语法：
+//   - 规则之间用逗号分隔，规则名与值之间用第一个等号分隔，值中可以再出现等号；
+//   - 值中的 '|' 是列表分隔符，例如 in=active|pending|disabled；
+//   - 单引号包裹的部分按字面处理，可以包含逗号、'|' 和首尾空格，例如 in='a,b'|'c d'；
+//     引号内只有 \' 和 \\ 是转义序列；
+//   - 引号外的反斜杠可以转义 , | ' \ 这几个字符，其余反斜杠原样保留（便于书写正则，如 \d）。
 func ParseTag(tag string) ([]Rule, error) {
 	var rules []Rule
 
-	// 去掉首尾空格并按逗号分割
-	parts := strings.Split(strings.TrimSpace(tag), ",")
-
-	for _, part := range parts {
-		part = strings.TrimSpace(part)
-		if part == "" {
+	s := &scanner{src: []rune(tag)}
+	for {
+		s.skipSpaces()
+		if s.eof() {
+			break
+		}
+		if s.peek() == ',' {
+			// 忽略空规则，例如 "required,,min=2"
+			s.pos++
 			continue
 		}
 
-		rule := Rule{}
-		// 检查是否有等号，例如 "min=2"
-		if eqIndex := strings.Index(part, "="); eqIndex != -1 {
-			rule.Name = part[:eqIndex]
-			rule.Value = part[eqIndex+1:]
-		} else {
-			// 没有等号，例如 "required"
-			rule.Name = part
-			rule.Value = ""
+		rule, err := s.scanRule()
+		if err != nil {
+			return nil, err
 		}
+		rules = append(rules, rule)
+	}
 
-		// 基本验证：规则名不能为空
-		if rule.Name == "" {
-			return nil, fmt.Errorf("invalid tag part: %s", part)
+	if err := checkDive(rules); err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+// checkDive 检查 dive/keys/endkeys 的结构（以及这些标记规则和 omitempty 不带值）：
+// dive 之后的规则作用于切片、数组或 map 的每个元素；
+// 紧跟在 dive 之后的 keys ... endkeys 之间的规则作用于 map 的键。
+func checkDive(rules []Rule) error {
+	for i := 0; i < len(rules); i++ {
+		switch rules[i].Name {
+		case "dive", "keys", "endkeys", "omitempty":
+			if rules[i].Value != "" {
+				return fmt.Errorf("rule %s takes no value", rules[i].Name)
+			}
 		}
 
-		// 预留：可以在这里对 rule.Value 进行类型检查
-		// 例如，如果 rule.Name 是 "min"，则 rule.Value 应该是数字
-		// 我们将在后续步骤中实现
+		switch rules[i].Name {
+		case "keys":
+			if i == 0 || rules[i-1].Name != "dive" {
+				return fmt.Errorf("'keys' must immediately follow 'dive'")
+			}
+			j := i + 1
+			for ; j < len(rules) && rules[j].Name != "endkeys"; j++ {
+				if rules[j].Name == "dive" || rules[j].Name == "keys" {
+					return fmt.Errorf("'%s' is not allowed between 'keys' and 'endkeys'", rules[j].Name)
+				}
+			}
+			if j == len(rules) {
+				return fmt.Errorf("'keys' without matching 'endkeys'")
+			}
+			i = j
+		case "endkeys":
+			return fmt.Errorf("'endkeys' without matching 'keys'")
+		}
+	}
+	return nil
+}
 
-		rules = append(rules, rule)
+// scanner 是 vgen tag 的逐字符扫描器
+type scanner struct {
+	src []rune
+	pos int
+}
+
+func (s *scanner) eof() bool  { return s.pos >= len(s.src) }
+func (s *scanner) peek() rune { return s.src[s.pos] }
+
+func (s *scanner) skipSpaces() {
+	for !s.eof() && unicode.IsSpace(s.peek()) {
+		s.pos++
 	}
+}
 
-	return rules, nil
+// scanRule 读取一条规则，直到遇到规则分隔符 ',' 或输入结束
+func (s *scanner) scanRule() (Rule, error) {
+	start := s.pos
+	for !s.eof() && s.peek() != ',' && s.peek() != '=' {
+		s.pos++
+	}
+	rule := Rule{Name: strings.TrimSpace(string(s.src[start:s.pos]))}
+
+	// 基本验证：规则名不能为空，且只能由字母、数字和下划线组成
+	if rule.Name == "" {
+		return Rule{}, fmt.Errorf("invalid tag part at offset %d: missing rule name", start)
+	}
+	for _, r := range rule.Name {
+		if r != '_' && !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			return Rule{}, fmt.Errorf("invalid rule name %q", rule.Name)
+		}
+	}
+
+	if s.eof() || s.peek() == ',' {
+		// 没有等号，例如 "required"
+		return rule, nil
+	}
+
+	// 跳过 '='
+	s.pos++
+	values, raw, err := s.scanValues()
+	if err != nil {
+		return Rule{}, fmt.Errorf("rule %s: %w", rule.Name, err)
+	}
+	rule.Values = values
+	rule.Raw = raw
+	rule.Value = strings.Join(values, "|")
+	return rule, nil
+}
+
+// item 是值列表中的一个元素；lit 标记每个字符是否来自引号或转义，这些字符不参与首尾空格裁剪
+type item struct {
+	chars  []rune
+	lit    []bool
+	quoted bool
+}
+
+func (it *item) add(r rune, lit bool) {
+	it.chars = append(it.chars, r)
+	it.lit = append(it.lit, lit)
+}
+
+// text 返回去掉首尾（非字面）空格后的元素文本
+func (it *item) text() string {
+	i, j := 0, len(it.chars)
+	for i < j && !it.lit[i] && unicode.IsSpace(it.chars[i]) {
+		i++
+	}
+	for j > i && !it.lit[j-1] && unicode.IsSpace(it.chars[j-1]) {
+		j--
+	}
+	return string(it.chars[i:j])
+}
+
+// scanValues 读取 '=' 之后的值，按未转义、未加引号的 '|' 拆分成列表，同时返回不拆分的完整值
+func (s *scanner) scanValues() ([]string, string, error) {
+	var values []string
+	cur, raw := &item{}, &item{}
+	add := func(r rune, lit bool) {
+		cur.add(r, lit)
+		raw.add(r, lit)
+	}
+
+	flush := func() {
+		// 未加引号的空元素被忽略（例如 "a||b"），'' 则表示显式的空字符串
+		if text := cur.text(); text != "" || cur.quoted {
+			values = append(values, text)
+		}
+		cur = &item{}
+	}
+
+	for !s.eof() {
+		r := s.peek()
+		switch r {
+		case ',':
+			flush()
+			return values, raw.text(), nil
+		case '|':
+			s.pos++
+			raw.add('|', false)
+			flush()
+		case '\'':
+			s.pos++
+			quoted := &item{}
+			if err := s.scanQuoted(quoted); err != nil {
+				return nil, "", err
+			}
+			cur.quoted = true
+			for _, r := range quoted.chars {
+				add(r, true)
+			}
+		case '\\':
+			s.pos++
+			if !s.eof() && strings.ContainsRune(`,|'\`, s.peek()) {
+				add(s.peek(), true)
+				s.pos++
+			} else {
+				add('\\', false)
+			}
+		default:
+			add(r, false)
+			s.pos++
+		}
+	}
+
+	flush()
+	return values, raw.text(), nil
+}
+
+// scanQuoted 读取单引号内的内容（起始引号已被跳过）
+func (s *scanner) scanQuoted(cur *item) error {
+	start := s.pos - 1
+	for !s.eof() {
+		r := s.peek()
+		s.pos++
+		switch r {
+		case '\'':
+			return nil
+		case '\\':
+			if !s.eof() && (s.peek() == '\'' || s.peek() == '\\') {
+				cur.add(s.peek(), true)
+				s.pos++
+				continue
+			}
+		}
+		cur.add(r, true)
+	}
+	return fmt.Errorf("unterminated quote at offset %d", start)
 }
 
 // GetIntValue 是一个辅助函数，用于安全地从 Rule.Value 获取整数值
@@ -65,21 +248,12 @@ func (r *Rule) GetIntValue() (int, error) {
 	return v, nil
 }
 
-// GetInValues 解析 'in' 规则的值，返回一个字符串切片。
-// 例如，对于 Value = "a,b,c"，将返回 []string{"a", "b", "c"}。
-// 如果规则不是 'in' 或 Value 为空，则返回 nil。
+// GetInValues 返回 'in' 规则允许的所有值。
+// 例如，对于 in=a|b|'c,d'，将返回 []string{"a", "b", "c,d"}。
+// 如果规则不是 'in' 或值为空，则返回 nil。
 func (r Rule) GetInValues() []string {
-	if r.Name != "in" || r.Value == "" {
+	if r.Name != "in" {
 		return nil
 	}
-	// 按逗号分割，并去除每个值前后的空格
-	parts := strings.Split(r.Value, ",")
-	values := make([]string, 0, len(parts))
-	for _, part := range parts {
-		trimmed := strings.TrimSpace(part)
-		if trimmed != "" { // 忽略空字符串
-			values = append(values, trimmed)
-		}
-	}
-	return values
+	return r.Values
 }
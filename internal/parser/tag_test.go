@@ -0,0 +1,174 @@
+package parser
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseTag(t *testing.T) {
+	tests := []struct {
+		name string
+		tag  string
+		want []Rule
+	}{
+		{
+			name: "SimpleRules",
+			tag:  "required,min=2,max=50",
+			want: []Rule{
+				{Name: "required"},
+				{Name: "min", Value: "2", Values: []string{"2"}, Raw: "2"},
+				{Name: "max", Value: "50", Values: []string{"50"}, Raw: "50"},
+			},
+		},
+		{
+			name: "ListValues",
+			tag:  "in=active|pending|disabled",
+			want: []Rule{
+				{Name: "in", Value: "active|pending|disabled", Values: []string{"active", "pending", "disabled"}, Raw: "active|pending|disabled"},
+			},
+		},
+		{
+			name: "SpacesAroundTokens",
+			tag:  " required , in = a | b c ",
+			want: []Rule{
+				{Name: "required"},
+				{Name: "in", Value: "a|b c", Values: []string{"a", "b c"}, Raw: "a | b c"},
+			},
+		},
+		{
+			name: "QuotedValues",
+			tag:  "in='a,b'|' c '|'x=y',min=1",
+			want: []Rule{
+				{Name: "in", Value: "a,b| c |x=y", Values: []string{"a,b", " c ", "x=y"}, Raw: "a,b| c |x=y"},
+				{Name: "min", Value: "1", Values: []string{"1"}, Raw: "1"},
+			},
+		},
+		{
+			name: "EqualsInValue",
+			tag:  "contains=a=b",
+			want: []Rule{
+				{Name: "contains", Value: "a=b", Values: []string{"a=b"}, Raw: "a=b"},
+			},
+		},
+		{
+			name: "EscapedSeparators",
+			tag:  `in=a\,b|c\|d|e\'f|g\\h`,
+			want: []Rule{
+				{Name: "in", Value: `a,b|c|d|e'f|g\h`, Values: []string{"a,b", "c|d", "e'f", `g\h`}, Raw: `a,b|c|d|e'f|g\h`},
+			},
+		},
+		{
+			name: "EscapesInsideQuotes",
+			tag:  `in='it\'s'|'a\\b'|'\d'`,
+			want: []Rule{
+				{Name: "in", Value: `it's|a\b|\d`, Values: []string{"it's", `a\b`, `\d`}, Raw: `it's|a\b|\d`},
+			},
+		},
+		{
+			name: "BackslashKeptForRegex",
+			tag:  `pattern=^\d+$`,
+			want: []Rule{
+				{Name: "pattern", Value: `^\d+$`, Values: []string{`^\d+$`}, Raw: `^\d+$`},
+			},
+		},
+		{
+			name: "EmptyItems",
+			tag:  "in=a||b|'',,required",
+			want: []Rule{
+				{Name: "in", Value: "a|b|", Values: []string{"a", "b", ""}, Raw: "a||b|"},
+				{Name: "required"},
+			},
+		},
+		{
+			name: "RawKeepsEmptyAlternatives",
+			tag:  "pattern=^(a||b)$,pattern=x|,pattern=^a | b$",
+			want: []Rule{
+				{Name: "pattern", Value: "^(a|b)$", Values: []string{"^(a", "b)$"}, Raw: "^(a||b)$"},
+				{Name: "pattern", Value: "x", Values: []string{"x"}, Raw: "x|"},
+				{Name: "pattern", Value: "^a|b$", Values: []string{"^a", "b$"}, Raw: "^a | b$"},
+			},
+		},
+		{
+			name: "RawResolvesQuotesAndEscapes",
+			tag:  `pattern='^(a|b){1,3}$' ,pattern=\\d\,`,
+			want: []Rule{
+				{Name: "pattern", Value: "^(a|b){1,3}$", Values: []string{"^(a|b){1,3}$"}, Raw: "^(a|b){1,3}$"},
+				{Name: "pattern", Value: `\d,`, Values: []string{`\d,`}, Raw: `\d,`},
+			},
+		},
+		{
+			name: "DiveWithKeys",
+			tag:  "max=10,dive,keys,min=2,endkeys,required",
+			want: []Rule{
+				{Name: "max", Value: "10", Values: []string{"10"}, Raw: "10"},
+				{Name: "dive"},
+				{Name: "keys"},
+				{Name: "min", Value: "2", Values: []string{"2"}, Raw: "2"},
+				{Name: "endkeys"},
+				{Name: "required"},
+			},
+		},
+		{
+			name: "OmitEmpty",
+			tag:  "omitempty,email",
+			want: []Rule{
+				{Name: "omitempty"},
+				{Name: "email"},
+			},
+		},
+		{
+			name: "Empty",
+			tag:  "",
+			want: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseTag(tt.tag)
+			if err != nil {
+				t.Fatalf("ParseTag(%q) returned error: %v", tt.tag, err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseTag(%q) = %#v, want %#v", tt.tag, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseTagErrors(t *testing.T) {
+	tests := []string{
+		"=5",
+		"min=1,=2",
+		"in='a|b",
+		"bad name=1",
+		"in'x'=1",
+		"keys,min=1,endkeys",
+		"dive,keys,min=1",
+		"dive,min=1,endkeys",
+		"dive,keys,dive,endkeys",
+		"dive=1,min=1",
+		"omitempty=true,email",
+	}
+
+	for _, tag := range tests {
+		if rules, err := ParseTag(tag); err == nil {
+			t.Errorf("ParseTag(%q) = %#v, want error", tag, rules)
+		}
+	}
+}
+
+func TestGetInValues(t *testing.T) {
+	rules, err := ParseTag("in=active|'on hold'|'a,b'")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"active", "on hold", "a,b"}
+	if got := rules[0].GetInValues(); !reflect.DeepEqual(got, want) {
+		t.Errorf("GetInValues() = %q, want %q", got, want)
+	}
+
+	if got := (Rule{Name: "min", Value: "1", Values: []string{"1"}, Raw: "1"}).GetInValues(); got != nil {
+		t.Errorf("GetInValues() on non-in rule = %q, want nil", got)
+	}
+}
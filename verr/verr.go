@@ -0,0 +1,91 @@
+// Package verr 定义 vgen 生成的 Validate() 方法返回的结构化校验错误。
+//
+// 生成代码在校验失败时返回 ValidationErrors，调用方可以用 errors.As 取出全部字段错误，
+// 或者取出第一个 *FieldError，再按 Path、Rule 等字段映射成 API 的错误响应（例如 HTTP 422）。
+package verr
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// FieldError 描述单个字段未通过某条规则的校验
+type FieldError struct {
+	Path  string `json:"path"`            // 字段的完整路径，例如 "Name"、"Address.Zip" 或 "Items[3].SKU"
+	Field string `json:"field"`           // 字段名，即路径的最后一段
+	Rule  string `json:"rule"`            // 未通过的规则名，例如 "min"
+	Param string `json:"param,omitempty"` // 规则的参数，例如 min=2 中的 "2"
+	Value any    `json:"value,omitempty"` // 字段在校验时的实际值
+	Msg   string `json:"message"`         // 可读的错误描述，例如 "length must be at least 2, got 1"
+}
+
+// Error 返回形如 "field Name length must be at least 2, got 1" 的错误信息
+func (e *FieldError) Error() string {
+	return "field " + e.Path + " " + e.Msg
+}
+
+// ValidationErrors 是一次校验中收集到的所有字段错误，按字段声明和规则书写的顺序排列
+type ValidationErrors []*FieldError
+
+// Error 把所有字段错误用 "; " 连接起来
+func (es ValidationErrors) Error() string {
+	msgs := make([]string, len(es))
+	for i, e := range es {
+		msgs[i] = e.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Unwrap 返回每个字段错误，使 errors.Is 和 errors.As 可以直接匹配单个 *FieldError
+func (es ValidationErrors) Unwrap() []error {
+	errs := make([]error, len(es))
+	for i, e := range es {
+		errs[i] = e
+	}
+	return errs
+}
+
+// Nest 把嵌套结构体的 Validate() 返回的错误追加到 es 并返回结果。
+// 每个字段错误的 Path 都会加上 prefix 前缀，例如 "Zip" 变为 "Address.Zip"；
+// prefix 为空（嵌入结构体）时路径保持不变。err 不是 ValidationErrors 或 *FieldError 时
+// （例如手写的 Validate() 返回的普通错误），整体记录为 field 字段上 Rule 为 "validate" 的错误。
+func (es ValidationErrors) Nest(prefix, field string, err error) ValidationErrors {
+	if err == nil {
+		return es
+	}
+
+	var nested ValidationErrors
+	var fe *FieldError
+	switch {
+	case errors.As(err, &nested):
+	case errors.As(err, &fe):
+		nested = ValidationErrors{fe}
+	default:
+		path := prefix
+		if path == "" {
+			path = field
+		}
+		return append(es, &FieldError{Path: path, Field: field, Rule: "validate", Msg: "is invalid: " + err.Error()})
+	}
+
+	for _, e := range nested {
+		c := *e
+		if prefix != "" {
+			c.Path = prefix + "." + e.Path
+		}
+		es = append(es, &c)
+	}
+	return es
+}
+
+// Index 返回切片或数组元素的路径，例如 Index("Items", 3) 返回 "Items[3]"
+func Index(path string, i int) string {
+	return path + "[" + strconv.Itoa(i) + "]"
+}
+
+// Key 返回 map 元素的路径，例如 Key("Attrs", "color") 返回 "Attrs[color]"
+func Key(path string, key any) string {
+	return path + "[" + fmt.Sprint(key) + "]"
+}
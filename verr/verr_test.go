@@ -0,0 +1,65 @@
+package verr
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValidationErrors(t *testing.T) {
+	var err error = ValidationErrors{
+		{Path: "Name", Field: "Name", Rule: "min", Param: "2", Value: "A", Msg: "length must be at least 2, got 1"},
+		{Path: "Email", Field: "Email", Rule: "email", Value: "x", Msg: "is not a valid email"},
+	}
+
+	want := "field Name length must be at least 2, got 1; field Email is not a valid email"
+	if got := err.Error(); got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+
+	var ves ValidationErrors
+	if !errors.As(err, &ves) || len(ves) != 2 {
+		t.Fatalf("errors.As(ValidationErrors) = %v, want 2 errors", ves)
+	}
+
+	var fe *FieldError
+	if !errors.As(err, &fe) {
+		t.Fatal("errors.As(*FieldError) = false, want true")
+	}
+	if fe.Path != "Name" || fe.Rule != "min" || fe.Param != "2" {
+		t.Errorf("errors.As(*FieldError) = %+v, want the first field error", fe)
+	}
+
+	if !errors.Is(err, ves[1]) {
+		t.Error("errors.Is should match an individual field error")
+	}
+}
+
+func TestNest(t *testing.T) {
+	inner := ValidationErrors{
+		{Path: "Zip", Field: "Zip", Rule: "len", Param: "5", Msg: "length must be 5, got 3"},
+	}
+
+	var es ValidationErrors
+	es = es.Nest("Address", "Address", inner)
+	es = es.Nest(Index("Items", 3), "Items", inner)
+	es = es.Nest(Key("Gifts", "bob"), "Gifts", inner)
+	es = es.Nest("", "Audit", inner)
+	es = es.Nest("Custom", "Custom", errors.New("boom"))
+	es = es.Nest("Skipped", "Skipped", nil)
+
+	want := []string{"Address.Zip", "Items[3].Zip", "Gifts[bob].Zip", "Zip", "Custom"}
+	if len(es) != len(want) {
+		t.Fatalf("Nest produced %d errors, want %d: %v", len(es), len(want), es)
+	}
+	for i, path := range want {
+		if es[i].Path != path {
+			t.Errorf("es[%d].Path = %q, want %q", i, es[i].Path, path)
+		}
+	}
+	if es[4].Rule != "validate" || es[4].Msg != "is invalid: boom" {
+		t.Errorf("plain error wrapped as %+v", es[4])
+	}
+	if inner[0].Path != "Zip" {
+		t.Errorf("Nest modified the nested error: %+v", inner[0])
+	}
+}